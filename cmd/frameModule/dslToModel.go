@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/xeipuuv/gojsonschema"
@@ -220,12 +221,127 @@ func convertRouteArguments(route string) []RouteArgument {
 	routeArguments := make([]RouteArgument, len(args))
 
 	for i, arg := range args {
-		routeArguments[i] = RouteArgument{Name: arg}
+		name, argType, _ := strings.Cut(arg, ":")
+		routeArguments[i] = RouteArgument{Name: strings.TrimSpace(name), Type: strings.TrimSpace(argType)}
 	}
 	return routeArguments
 }
 
-func generateFrame(frameDSL FrameDSLModel) (FrameProductionDataWrapper, error) {
+func normalizeRoute(route string) string {
+	re := regexp.MustCompile(`\{([^}:]*):[^}]*\}`)
+	return re.ReplaceAllString(route, "{$1}")
+}
+
+func validateVariables(variables []VariableDSLModel) error {
+	keys := make(map[string]bool)
+	for _, variable := range variables {
+		if keys[variable.Key] {
+			return fmt.Errorf("duplicate variable key found: %s", variable.Key)
+		}
+		keys[variable.Key] = true
+
+		if err := validateValueType(variable.Value, variable.Type); err != nil {
+			return fmt.Errorf("invalid value for %s variable: %v", variable.Key, err)
+		}
+	}
+	return nil
+}
+
+func validateRouteArguments(routeArguments []RouteArgument, variables []VariableDSLModel) error {
+	for _, argument := range routeArguments {
+		if argument.Type != "" && !isVariableType(argument.Type) {
+			return fmt.Errorf("route argument %s has an unsupported type: %s", argument.Name, argument.Type)
+		}
+
+		found := false
+		for _, variable := range variables {
+			if variable.Key == argument.Name {
+				found = true
+				if argument.Type != "" && variable.Type != argument.Type {
+					return fmt.Errorf("route argument %s is %s but its variable is %s", argument.Name, argument.Type, variable.Type)
+				}
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("no matching variable found for route argument: %s", argument.Name)
+		}
+	}
+	return nil
+}
+
+func isVariableType(valueType string) bool {
+	switch valueType {
+	case "STRING", "INT", "LONG", "DOUBLE", "FLOAT", "BOOLEAN":
+		return true
+	}
+	return false
+}
+
+func validateValueType(value string, valueType string) error {
+	var err error
+	switch valueType {
+	case "INT":
+		_, err = strconv.ParseInt(value, 10, 32)
+	case "LONG":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case "DOUBLE":
+		_, err = strconv.ParseFloat(value, 64)
+	case "FLOAT":
+		_, err = strconv.ParseFloat(value, 32)
+	case "BOOLEAN":
+		if value != "true" && value != "false" {
+			err = errors.New("must be true or false")
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("%q is not a valid %s", value, valueType)
+	}
+	return nil
+}
+
+func prepareFrameDSL(frameDSL FrameDSLModel, options frameGenerateOptions) (FrameDSLModel, error) {
+	frameDSL, err := expandFrameIncludes(frameDSL, options.BaseDir)
+	if err != nil {
+		return FrameDSLModel{}, err
+	}
+
+	frameDSL = expandFramePropertyValues(frameDSL)
+
+	frameDSL, err = substituteFramePlaceholders(frameDSL, options.Values)
+	if err != nil {
+		return FrameDSLModel{}, err
+	}
+
+	if options.Locale != "" {
+		frameDSL, err = resolveFrameStrings(frameDSL, options.BaseDir, options.Locale)
+		if err != nil {
+			return FrameDSLModel{}, err
+		}
+	} else if refs := findFrameStringRefs(frameDSL); len(refs) > 0 {
+		return FrameDSLModel{}, fmt.Errorf("the frame uses @string values, please provide --locale to resolve them: %s", strings.Join(refs, ","))
+	}
+
+	if options.FillDefaults {
+		blocks, actions := options.Blocks, options.Actions
+		if blocks == nil && actions == nil {
+			blocks, actions, err = loadIntegrationSchemas("", "", options.BaseDir, frameDSL.Schema)
+			if err != nil {
+				return FrameDSLModel{}, err
+			}
+		}
+		frameDSL = fillFrameDefaults(frameDSL, blocks, actions)
+	}
+
+	return frameDSL, nil
+}
+
+func generateFrame(frameDSL FrameDSLModel, options frameGenerateOptions) (FrameProductionDataWrapper, error) {
+	frameDSL, err := prepareFrameDSL(frameDSL, options)
+	if err != nil {
+		return FrameProductionDataWrapper{}, err
+	}
+
 	if frameDSL.Schema == "" {
 		return FrameProductionDataWrapper{}, errors.New("please provide $schema for the json file")
 	}
@@ -245,6 +361,32 @@ func generateFrame(frameDSL FrameDSLModel) (FrameProductionDataWrapper, error) {
 		return FrameProductionDataWrapper{}, nil
 	}
 
+	err = validateVariables(frameDSL.Variables)
+	if err != nil {
+		return FrameProductionDataWrapper{}, err
+	}
+
+	routeArguments := convertRouteArguments(frameDSL.Route)
+	err = validateRouteArguments(routeArguments, frameDSL.Variables)
+	if err != nil {
+		return FrameProductionDataWrapper{}, err
+	}
+
+	config, err := loadFrameProjectConfig(options.BaseDir)
+	if err != nil {
+		return FrameProductionDataWrapper{}, err
+	}
+
+	err = validateFrameType(frameDSL, config.frameTypeRules())
+	if err != nil {
+		return FrameProductionDataWrapper{}, err
+	}
+
+	err = checkFrameDeprecations(frameDSL, options)
+	if err != nil {
+		return FrameProductionDataWrapper{}, err
+	}
+
 	frameId := generateId()
 
 	var variables []VariableModel
@@ -280,8 +422,8 @@ func generateFrame(frameDSL FrameDSLModel) (FrameProductionDataWrapper, error) {
 	frame := FrameModel{
 		Id:             frameId,
 		Name:           frameDSL.Name,
-		Route:          frameDSL.Route,
-		RouteArguments: convertRouteArguments(frameDSL.Route),
+		Route:          normalizeRoute(frameDSL.Route),
+		RouteArguments: routeArguments,
 		Type:           frameDSL.Type,
 		IsStarter:      frameDSL.IsStarter,
 		Variables:      variables,
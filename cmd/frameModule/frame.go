@@ -1,13 +1,20 @@
 package frameModule
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/nativeblocks/cli/cmd/authModule"
 	"github.com/nativeblocks/cli/cmd/projectModule"
 	"github.com/nativeblocks/cli/cmd/regionModule"
 	"github.com/nativeblocks/cli/library/fileutil"
+	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 )
 
@@ -20,11 +27,31 @@ func FrameCmd() *cobra.Command {
 	cmd.AddCommand(genCommand())
 	cmd.AddCommand(pushCommand())
 	cmd.AddCommand(pullCommand())
+	cmd.AddCommand(listCommand())
+	cmd.AddCommand(newCommand())
+	cmd.AddCommand(lintCommand())
+	cmd.AddCommand(fmtCommand())
+	cmd.AddCommand(watchCommand())
+	cmd.AddCommand(serveCommand())
+	cmd.AddCommand(upgradeCommand())
+	cmd.AddCommand(graphCommand())
+	cmd.AddCommand(treeCommand())
+	cmd.AddCommand(simulateCommand())
+	cmd.AddCommand(checkCommand())
+	cmd.AddCommand(searchCommand())
+	cmd.AddCommand(mergeCommand())
+	cmd.AddCommand(historyCommand())
+	cmd.AddCommand(rollbackCommand())
+	cmd.AddCommand(statsCommand())
+	cmd.AddCommand(localeCommand())
+	cmd.AddCommand(convertCommand())
 	return cmd
 }
 
 func genCommand() *cobra.Command {
 	var path string
+	var strict bool
+	var generateFlags frameGenerateFlags
 	cmd := &cobra.Command{
 		Use:   "gen",
 		Short: "Generate a frame",
@@ -42,13 +69,36 @@ func genCommand() *cobra.Command {
 				return fmt.Errorf("could not find the file under: %v", path)
 			}
 
-			var jsonDSL FrameDSLModel
-			err = fm.LoadFromFile(fileName, &jsonDSL)
+			jsonDSL, err := loadFrameDSL(path)
 			if err != nil {
 				return err
 			}
 
-			output, err := generateFrame(jsonDSL)
+			options, err := generateFlags.options(baseDir)
+			if err != nil {
+				return err
+			}
+
+			options.StrictDeprecations = strict
+			options.Warnings = cmd.ErrOrStderr()
+			if strict {
+				baseFm, err := fileutil.NewFileManager(nil)
+				if err != nil {
+					return err
+				}
+
+				options.Blocks, options.Actions, err = loadInstalledIntegrationSchemas(*baseFm)
+				if err != nil {
+					return fmt.Errorf("failed to load installed integrations: %v", err)
+				}
+			} else {
+				options.Blocks, options.Actions, err = loadIntegrationSchemas("", "", baseDir, jsonDSL.Schema)
+				if err != nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "warning: skipping deprecation check, failed to load integration schemas: %v\n", err)
+				}
+			}
+
+			output, err := generateFrame(jsonDSL, options)
 			if err != nil {
 				return err
 			}
@@ -69,6 +119,8 @@ func genCommand() *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame working path")
+	cmd.Flags().BoolVar(&strict, "strict", false, "Fail when the frame uses deprecated blocks, actions, properties, data, events or slots")
+	generateFlags.bind(cmd)
 	_ = cmd.MarkFlagRequired("path")
 
 	return cmd
@@ -76,6 +128,11 @@ func genCommand() *cobra.Command {
 
 func pushCommand() *cobra.Command {
 	var path string
+	var strict bool
+	var noHistory bool
+	var dryRun bool
+	var dryRunOutput string
+	var generateFlags frameGenerateFlags
 	cmd := &cobra.Command{
 		Use:   "push",
 		Short: "Push a frame",
@@ -113,18 +170,41 @@ func pushCommand() *cobra.Command {
 				return fmt.Errorf("could not find the file under: %v", path)
 			}
 
-			var jsonDSL FrameDSLModel
-			err = inputFm.LoadFromFile(fileName, &jsonDSL)
+			jsonDSL, err := loadFrameDSL(path)
+			if err != nil {
+				return err
+			}
+
+			options, err := generateFlags.options(baseDir)
 			if err != nil {
 				return err
 			}
 
-			output, err := generateFrame(jsonDSL)
+			options.StrictDeprecations = strict
+			options.Warnings = cmd.ErrOrStderr()
+			options.Blocks, options.Actions, err = loadInstalledIntegrationSchemas(*baseFm)
+			if err != nil {
+				if strict {
+					return fmt.Errorf("failed to load installed integrations: %v", err)
+				}
+				fmt.Fprintf(cmd.ErrOrStderr(), "warning: skipping deprecation check, failed to load installed integrations: %v\n", err)
+			}
+
+			output, err := generateFrame(jsonDSL, options)
 			if err != nil {
 				return err
 			}
 
-			err = pushFrame(output, region.Url, auth.AccessToken, project.APIKeys[0].APIKey)
+			if dryRun {
+				return dryRunPushFrame(output, jsonDSL.Schema, dryRunOutput, region.Url, auth.AccessToken, project.APIKeys[0].APIKey)
+			}
+
+			if noHistory {
+				err = pushFrame(output, region.Url, auth.AccessToken, project.APIKeys[0].APIKey)
+			} else {
+				historyDir := frameHistoryDir(*baseFm, project.Id, output.Data.FrameProduction.Route)
+				err = pushFrameWithHistory(output, jsonDSL.Schema, historyDir, region.Url, auth.AccessToken, project.APIKeys[0].APIKey)
+			}
 			if err != nil {
 				return err
 			}
@@ -136,6 +216,11 @@ func pushCommand() *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame working path")
+	cmd.Flags().BoolVar(&strict, "strict", false, "Fail when the frame uses deprecated blocks, actions, properties, data, events or slots")
+	cmd.Flags().BoolVar(&noHistory, "no-history", false, "Do not store the previous and pushed versions in the local history")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the sync input and the changes against the remote frame without pushing")
+	cmd.Flags().StringVarP(&dryRunOutput, "output", "o", "", "Write the sync input of a dry run into a file")
+	generateFlags.bind(cmd)
 	_ = cmd.MarkFlagRequired("path")
 
 	return cmd
@@ -143,10 +228,20 @@ func pushCommand() *cobra.Command {
 
 func pullCommand() *cobra.Command {
 	var path string
+	var all bool
+	var output string
+	var schema string
 	cmd := &cobra.Command{
 		Use:   "pull",
 		Short: "Pull a frame",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if !all && path == "" {
+				return fmt.Errorf("please provide the frame path or use --all")
+			}
+			if all && schema == "" {
+				return fmt.Errorf("please provide the $schema url with --schema when pulling all frames")
+			}
+
 			baseFm, err := fileutil.NewFileManager(nil)
 			if err != nil {
 				return err
@@ -167,6 +262,36 @@ func pullCommand() *cobra.Command {
 				return err
 			}
 
+			if all {
+				outputFm, err := fileutil.NewFileManager(&output)
+				if err != nil {
+					return err
+				}
+
+				frames, err := getFrames(region.Url, auth.AccessToken, project.APIKeys[0].APIKey)
+				if err != nil {
+					return err
+				}
+
+				var routes []string
+				for _, frame := range frames {
+					routes = append(routes, frame.Route)
+				}
+				fileNames := frameFileNames(routes)
+
+				for _, frame := range frames {
+					fileName := fileNames[frame.Route]
+					err = pullFrame(*outputFm, region.Url, auth.AccessToken, project.APIKeys[0].APIKey, fileName, schema, frame.Route)
+					if err != nil {
+						return err
+					}
+					fmt.Printf("Frame %s pulled into %s \n", frame.Route, outputFm.GetFilePath(fileName))
+				}
+
+				fmt.Printf("%v frames successfully synced \n", len(frames))
+				return nil
+			}
+
 			baseDir := fileutil.GetFileDir(path)
 			fileName := fileutil.GetFileName(path)
 
@@ -180,8 +305,7 @@ func pullCommand() *cobra.Command {
 				return fmt.Errorf("could not find the file under: %v", path)
 			}
 
-			var jsonDSL FrameDSLModel
-			err = inputFm.LoadFromFile(fileName, &jsonDSL)
+			jsonDSL, err := loadFrameDSL(path)
 			if err != nil {
 				return err
 			}
@@ -202,6 +326,1307 @@ func pullCommand() *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame working path")
+	cmd.Flags().BoolVar(&all, "all", false, "Pull all frames of the project")
+	cmd.Flags().StringVarP(&output, "output", "o", ".", "Output directory when pulling all frames")
+	cmd.Flags().StringVarP(&schema, "schema", "s", "", "Frame $schema url when pulling all frames")
+
+	return cmd
+}
+
+func listCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Get frame list",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseFm, err := fileutil.NewFileManager(nil)
+			if err != nil {
+				return err
+			}
+
+			region, err := regionModule.GetRegion(*baseFm)
+			if err != nil {
+				return err
+			}
+
+			auth, err := authModule.AuthGet(*baseFm)
+			if err != nil {
+				return err
+			}
+
+			project, err := projectModule.GetProject(*baseFm)
+			if err != nil {
+				return err
+			}
+
+			frames, err := getFrames(region.Url, auth.AccessToken, project.APIKeys[0].APIKey)
+			if err != nil {
+				return err
+			}
+
+			table := tablewriter.NewWriter(os.Stdout)
+			table.Header([]string{"Route", "Name", "Type", "IsStarter"})
+
+			for _, frame := range frames {
+				table.Append([]string{
+					frame.Route,
+					frame.Name,
+					frame.Type,
+					fmt.Sprintf("%v", frame.IsStarter),
+				})
+			}
+			table.Render()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newCommand() *cobra.Command {
+	var path string
+	var route string
+	var frameType string
+	var name string
+	var schema string
+	var isStarter bool
+	var templateName string
+	var templatesDir string
+	var force bool
+	cmd := &cobra.Command{
+		Use:   "new",
+		Short: "Create a new frame from a template",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if path == "" {
+				path = frameFileName(route)
+			}
+			if name == "" {
+				name = routeSlug(route)
+			}
+
+			baseDir := fileutil.GetFileDir(path)
+			fileName := fileutil.GetFileName(path)
+
+			outputFm, err := fileutil.NewFileManager(&baseDir)
+			if err != nil {
+				return err
+			}
+
+			if outputFm.FileExists(fileName) && !force {
+				return fmt.Errorf("the file already exists: %v, use --force to overwrite it", path)
+			}
+
+			if templatesDir == "" {
+				projectDir := findProjectDir(baseDir)
+				if projectDir != "" {
+					templatesDir = filepath.Join(projectDir, fileutil.ConfigDirName, "templates")
+				}
+			}
+
+			routeArguments := convertRouteArguments(route)
+			for i := range routeArguments {
+				if routeArguments[i].Type == "" {
+					routeArguments[i].Type = "STRING"
+				}
+			}
+
+			frame, err := generateFrameFromTemplate(templateName, templatesDir, frameTemplateData{
+				Schema:         schema,
+				Name:           name,
+				Route:          route,
+				Type:           frameType,
+				IsStarter:      isStarter,
+				RouteArguments: routeArguments,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := saveFrameDSL(*outputFm, fileName, frame); err != nil {
+				return err
+			}
+
+			fmt.Printf("Frame created at %s \n", outputFm.GetFilePath(fileName))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file path, defaults to a file named after the route")
+	cmd.Flags().StringVarP(&route, "route", "r", "", "Frame route")
+	cmd.Flags().StringVarP(&frameType, "type", "t", "FRAME", "Frame type (FRAME, BOTTOM_SHEET or DIALOG)")
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Frame name, defaults to the route")
+	cmd.Flags().StringVarP(&schema, "schema", "s", "", "Frame $schema url")
+	cmd.Flags().BoolVar(&isStarter, "starter", false, "Mark the frame as the starter frame")
+	cmd.Flags().StringVar(&templateName, "template", "default", "Template name, built-in (default, empty) or from the templates directory")
+	cmd.Flags().StringVar(&templatesDir, "templates", "", "Templates directory, defaults to .nativeblocks/templates of the project")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Overwrite an existing file")
+	_ = cmd.MarkFlagRequired("route")
+	_ = cmd.MarkFlagRequired("schema")
+
+	return cmd
+}
+
+func lintCommand() *cobra.Command {
+	var path string
+	var blocksSchema string
+	var actionsSchema string
+	var strict bool
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Lint frames against the installed blocks and actions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := findFrameFiles(path)
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				return fmt.Errorf("could not find any frame under: %v", path)
+			}
+
+			firstFrame, err := loadFrameDSL(files[0])
+			if err != nil {
+				return err
+			}
+
+			blocks, actions, err := loadIntegrationSchemas(blocksSchema, actionsSchema, fileutil.GetFileDir(files[0]), firstFrame.Schema)
+			if err != nil {
+				return err
+			}
+
+			errorCount := 0
+			warningCount := 0
+			for _, file := range files {
+				frame, err := loadFrameDSL(file)
+				if err != nil {
+					return err
+				}
+
+				frame, err = expandFrameIncludes(frame, fileutil.GetFileDir(file))
+				if err != nil {
+					return fmt.Errorf("%s: %v", file, err)
+				}
+
+				issues := lintFrame(frame, blocks, actions)
+
+				config, err := loadFrameProjectConfig(fileutil.GetFileDir(file))
+				if err != nil {
+					return err
+				}
+				if err := validateFrameType(frame, config.frameTypeRules()); err != nil {
+					issues = append(issues, frameLintIssue{Severity: lintSeverityError, Path: "type", Message: err.Error()})
+				}
+
+				for _, issue := range findFrameDeprecations(frame, blocks, actions) {
+					if strict {
+						issue.Severity = lintSeverityError
+					}
+					issues = append(issues, issue)
+				}
+
+				for _, issue := range issues {
+					if issue.Severity == lintSeverityError {
+						errorCount++
+					} else {
+						warningCount++
+					}
+					fmt.Printf("%s: %s %s: %s\n", file, issue.Severity, issue.Path, issue.Message)
+				}
+			}
+
+			if errorCount > 0 {
+				return fmt.Errorf("%v errors and %v warnings found", errorCount, warningCount)
+			}
+
+			fmt.Printf("%v frames linted, %v warnings found \n", len(files), warningCount)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file or directory path")
+	cmd.Flags().StringVarP(&blocksSchema, "blocksSchemaUrl", "b", "", "Blocks schema url or path, defaults to blocks.json next to the $schema file or in .nativeblocks of the project")
+	cmd.Flags().StringVarP(&actionsSchema, "actionsSchemaUrl", "a", "", "Actions schema url or path, defaults to actions.json next to the $schema file or in .nativeblocks of the project")
+	cmd.Flags().BoolVar(&strict, "strict", false, "Report deprecated blocks, actions, properties, data, events and slots as errors")
+	_ = cmd.MarkFlagRequired("path")
+
+	return cmd
+}
+
+func fmtCommand() *cobra.Command {
+	var path string
+	var check bool
+	cmd := &cobra.Command{
+		Use:   "fmt",
+		Short: "Format frames into the canonical layout",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := findFrameFiles(path)
+			if err != nil {
+				return err
+			}
+
+			unformatted := 0
+			for _, file := range files {
+				original, err := os.ReadFile(file)
+				if err != nil {
+					return fmt.Errorf("failed to read file: %v", err)
+				}
+
+				frame, err := loadFrameDSL(file)
+				if err != nil {
+					return err
+				}
+
+				formatted, err := marshalFrameFile(file, frame)
+				if err != nil {
+					return err
+				}
+
+				if bytes.Equal(original, formatted) {
+					continue
+				}
+
+				unformatted++
+				if check {
+					fmt.Printf("%s is not formatted\n", file)
+					continue
+				}
+
+				if err := saveFrameFile(file, frame); err != nil {
+					return err
+				}
+				fmt.Printf("%s formatted\n", file)
+			}
+
+			if check && unformatted > 0 {
+				return fmt.Errorf("%v of %v frames are not formatted", unformatted, len(files))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file or directory path")
+	cmd.Flags().BoolVar(&check, "check", false, "Only check the formatting and fail when a frame is not formatted")
+	_ = cmd.MarkFlagRequired("path")
+
+	return cmd
+}
+
+func watchCommand() *cobra.Command {
+	var path string
+	var generateFlags frameGenerateFlags
+	var push bool
+	var interval time.Duration
+	var debounce time.Duration
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Watch frames, validate them on change and optionally push them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var regionUrl, accessToken, apiKey string
+			if push {
+				baseFm, err := fileutil.NewFileManager(nil)
+				if err != nil {
+					return err
+				}
+
+				region, err := regionModule.GetRegion(*baseFm)
+				if err != nil {
+					return err
+				}
+
+				auth, err := authModule.AuthGet(*baseFm)
+				if err != nil {
+					return err
+				}
+
+				project, err := projectModule.GetProject(*baseFm)
+				if err != nil {
+					return err
+				}
+
+				regionUrl = region.Url
+				accessToken = auth.AccessToken
+				apiKey = project.APIKeys[0].APIKey
+			}
+
+			options, err := generateFlags.options("")
+			if err != nil {
+				return err
+			}
+
+			watcher, files, err := newFrameWatcher(path)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("[%s] Watching %v frames under %s \n", time.Now().Format("15:04:05"), len(files), path)
+
+			watcher.watch(interval, debounce, func(changed []string) {
+				for _, file := range changed {
+					timestamp := time.Now().Format("15:04:05")
+
+					if _, err := os.Stat(file); os.IsNotExist(err) {
+						fmt.Printf("[%s] %s: removed\n", timestamp, file)
+						continue
+					}
+
+					jsonDSL, err := loadFrameDSL(file)
+					if err != nil {
+						fmt.Printf("[%s] %s: %v\n", timestamp, file, err)
+						continue
+					}
+
+					options.BaseDir = fileutil.GetFileDir(file)
+					output, err := generateFrame(jsonDSL, options)
+					if err != nil {
+						fmt.Printf("[%s] %s: %v\n", timestamp, file, err)
+						continue
+					}
+					if output.Data.FrameProduction.Id == "" {
+						fmt.Printf("[%s] %s: invalid frame\n", timestamp, file)
+						continue
+					}
+
+					if !push {
+						fmt.Printf("[%s] %s: valid\n", timestamp, file)
+						continue
+					}
+
+					err = pushFrame(output, regionUrl, accessToken, apiKey)
+					if err != nil {
+						fmt.Printf("[%s] %s: valid, push failed: %v\n", timestamp, file, err)
+						continue
+					}
+					fmt.Printf("[%s] %s: valid, pushed %s\n", timestamp, file, jsonDSL.Route)
+				}
+			}, func(err error) {
+				fmt.Printf("[%s] %v\n", time.Now().Format("15:04:05"), err)
+			})
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file or directory path")
+	cmd.Flags().BoolVar(&push, "push", false, "Push valid frames to the selected project")
+	cmd.Flags().DurationVar(&interval, "interval", 500*time.Millisecond, "Interval between file checks")
+	cmd.Flags().DurationVar(&debounce, "debounce", time.Second, "Time without changes before a cycle runs")
+	generateFlags.bind(cmd)
+	_ = cmd.MarkFlagRequired("path")
+
+	return cmd
+}
+
+func serveCommand() *cobra.Command {
+	var path string
+	var generateFlags frameGenerateFlags
+	var port int
+	var interval time.Duration
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve local frames through a graphql endpoint like the frame API",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options, err := generateFlags.options("")
+			if err != nil {
+				return err
+			}
+
+			watcher, files, err := newFrameWatcher(path)
+			if err != nil {
+				return err
+			}
+
+			server := newFrameServer(options)
+			server.reload(files)
+
+			go watcher.watch(interval, interval, server.reload, func(err error) {
+				fmt.Printf("[%s] %v\n", time.Now().Format("15:04:05"), err)
+			})
+
+			address := fmt.Sprintf(":%v", port)
+			fmt.Printf("Serving %v frames on http://localhost%s \n", len(server.allFrames()), address)
+			return http.ListenAndServe(address, server)
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file or directory path")
+	cmd.Flags().IntVar(&port, "port", 8080, "Server port")
+	cmd.Flags().DurationVar(&interval, "interval", 500*time.Millisecond, "Interval between file checks")
+	generateFlags.bind(cmd)
+	_ = cmd.MarkFlagRequired("path")
+
+	return cmd
+}
+
+func upgradeCommand() *cobra.Command {
+	var path string
+	var blocksSchema string
+	var actionsSchema string
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade frames to the installed integration versions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := findFrameFiles(path)
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				return fmt.Errorf("could not find any frame under: %v", path)
+			}
+
+			var blocks, actions map[string]IntegrationSchemaModel
+			if blocksSchema != "" || actionsSchema != "" {
+				var firstFrame FrameDSLModel
+				firstFrame, err = loadFrameDSL(files[0])
+				if err != nil {
+					return err
+				}
+				blocks, actions, err = loadIntegrationSchemas(blocksSchema, actionsSchema, fileutil.GetFileDir(files[0]), firstFrame.Schema)
+			} else {
+				baseFm, fmErr := fileutil.NewFileManager(nil)
+				if fmErr != nil {
+					return fmErr
+				}
+				blocks, actions, err = loadInstalledIntegrationSchemas(*baseFm)
+			}
+			if err != nil {
+				return err
+			}
+
+			upgradedCount := 0
+			var fragments []string
+			for _, file := range files {
+				frame, err := loadFrameDSL(file)
+				if err != nil {
+					return err
+				}
+				fragments = append(fragments, findBlockIncludes(frame.Blocks, fileutil.GetFileDir(file))...)
+
+				upgraded, changes := upgradeFrame(frame, blocks, actions)
+				if len(changes) == 0 {
+					continue
+				}
+
+				upgradedCount++
+				fmt.Printf("%s:\n", file)
+				for _, change := range changes {
+					fmt.Printf("  %s: %s\n", change.Path, change.Message)
+				}
+
+				if dryRun {
+					continue
+				}
+
+				if err := saveFrameFile(file, upgraded); err != nil {
+					return err
+				}
+			}
+
+			upgradedFragments := 0
+			seenFragments := make(map[string]bool)
+			for i := 0; i < len(fragments); i++ {
+				fragmentPath := fragments[i]
+				if seenFragments[fragmentPath] {
+					continue
+				}
+				seenFragments[fragmentPath] = true
+
+				fragment, err := loadBlockFragmentFile(fragmentPath)
+				if err != nil {
+					fmt.Printf("%s: skipped, %v\n", fragmentPath, err)
+					continue
+				}
+				fragments = append(fragments, findBlockIncludes([]BlockDSLModel{fragment}, fileutil.GetFileDir(fragmentPath))...)
+
+				upgraded, changes := upgradeBlockFragment(fragment, blocks, actions)
+				if len(changes) == 0 {
+					continue
+				}
+
+				upgradedFragments++
+				fmt.Printf("%s:\n", fragmentPath)
+				for _, change := range changes {
+					fmt.Printf("  %s: %s\n", change.Path, change.Message)
+				}
+
+				if dryRun {
+					continue
+				}
+
+				if err := saveBlockFragmentFile(fragmentPath, upgraded); err != nil {
+					return err
+				}
+			}
+
+			if dryRun {
+				fmt.Printf("%v of %v frames and %v of %v includes need an upgrade \n", upgradedCount, len(files), upgradedFragments, len(seenFragments))
+			} else {
+				fmt.Printf("%v of %v frames and %v of %v includes upgraded \n", upgradedCount, len(files), upgradedFragments, len(seenFragments))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file or directory path")
+	cmd.Flags().StringVarP(&blocksSchema, "blocksSchemaUrl", "b", "", "Blocks schema url or path, defaults to the installed blocks of the project")
+	cmd.Flags().StringVarP(&actionsSchema, "actionsSchemaUrl", "a", "", "Actions schema url or path, defaults to the installed actions of the project")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only print the migration report without changing the frames")
+	_ = cmd.MarkFlagRequired("path")
+
+	return cmd
+}
+
+func graphCommand() *cobra.Command {
+	var path string
+	var format string
+	var output string
+	var generateFlags frameGenerateFlags
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Export the block tree and action flows of a frame as a mermaid or dot graph",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options, err := generateFlags.options("")
+			if err != nil {
+				return err
+			}
+
+			frame, err := loadFrameModel(path, options)
+			if err != nil {
+				return err
+			}
+
+			graph, err := renderFrameGraph(buildFrameGraph(frame), format)
+			if err != nil {
+				return err
+			}
+
+			if output == "" {
+				fmt.Print(graph)
+				return nil
+			}
+
+			if err := os.WriteFile(output, []byte(graph), 0644); err != nil {
+				return fmt.Errorf("failed to write graph: %v", err)
+			}
+			fmt.Printf("Frame graph saved into %s \n", output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file path")
+	cmd.Flags().StringVarP(&format, "format", "f", "mermaid", "Graph format (mermaid or dot)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file, defaults to stdout")
+	generateFlags.bind(cmd)
+	_ = cmd.MarkFlagRequired("path")
+
+	return cmd
+}
+
+func treeCommand() *cobra.Command {
+	var path string
+	var route string
+	var triggers bool
+	var generateFlags frameGenerateFlags
+	cmd := &cobra.Command{
+		Use:   "tree",
+		Short: "Print the block hierarchy of a local or remote frame",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (path == "") == (route == "") {
+				return fmt.Errorf("please provide either the frame path or the route")
+			}
+
+			var frame FrameModel
+			if path != "" {
+				options, err := generateFlags.options("")
+				if err != nil {
+					return err
+				}
+
+				frame, err = loadFrameModel(path, options)
+				if err != nil {
+					return err
+				}
+			} else {
+				baseFm, err := fileutil.NewFileManager(nil)
+				if err != nil {
+					return err
+				}
+
+				region, err := regionModule.GetRegion(*baseFm)
+				if err != nil {
+					return err
+				}
+
+				auth, err := authModule.AuthGet(*baseFm)
+				if err != nil {
+					return err
+				}
+
+				project, err := projectModule.GetProject(*baseFm)
+				if err != nil {
+					return err
+				}
+
+				frame, err = getFrame(region.Url, auth.AccessToken, project.APIKeys[0].APIKey, route)
+				if err != nil {
+					return err
+				}
+				if frame.Route == "" {
+					return fmt.Errorf("could not find frame route %v", route)
+				}
+			}
+
+			fmt.Print(renderFrameTree(buildFrameTree(frame, triggers)))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file path")
+	cmd.Flags().StringVarP(&route, "route", "r", "", "Route of a remote frame")
+	cmd.Flags().BoolVarP(&triggers, "triggers", "t", false, "Expand the trigger chains of every event")
+	generateFlags.bind(cmd)
+
+	return cmd
+}
+
+func simulateCommand() *cobra.Command {
+	var path string
+	var blockKey string
+	var event string
+	var failures []string
+	var generateFlags frameGenerateFlags
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate the trigger flow of a block event",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			frameDSL, err := loadFrameDSL(path)
+			if err != nil {
+				return err
+			}
+
+			options, err := generateFlags.options(fileutil.GetFileDir(path))
+			if err != nil {
+				return err
+			}
+
+			frameDSL, err = prepareFrameDSL(frameDSL, options)
+			if err != nil {
+				return err
+			}
+
+			block := findBlockDSL(frameDSL.Blocks, blockKey)
+			if block == nil {
+				return fmt.Errorf("could not find block %v", blockKey)
+			}
+
+			action := findActionDSL(*block, event)
+			if action == nil {
+				return fmt.Errorf("could not find event %v on block %v", event, blockKey)
+			}
+
+			steps, issues, err := simulateAction(*action, failures)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("%s %s\n", blockKey, event)
+			for index, step := range steps {
+				fmt.Printf("%3d. %s%s %s then=%s -> %s\n", index+1, step.Depth, step.Trigger.KeyType, step.Trigger.Name, step.Trigger.Then, step.Outcome)
+			}
+			if len(steps) == 0 {
+				fmt.Printf("no trigger runs\n")
+			}
+
+			for _, issue := range issues {
+				fmt.Printf("%s: %s: %s\n", issue.Severity, issue.Path, issue.Message)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file path")
+	cmd.Flags().StringVarP(&blockKey, "block", "b", "", "Key of the block")
+	cmd.Flags().StringVarP(&event, "event", "e", "", "Event of the block")
+	cmd.Flags().StringSliceVar(&failures, "fail", []string{}, "Names of the triggers that fail, every other trigger succeeds")
+	generateFlags.bind(cmd)
+	_ = cmd.MarkFlagRequired("path")
+	_ = cmd.MarkFlagRequired("block")
+	_ = cmd.MarkFlagRequired("event")
+
+	return cmd
+}
+
+func checkCommand() *cobra.Command {
+	var path string
+	var routeProperties []string
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check routes, starter frame and navigation links across frames",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := findFrameFiles(path)
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				return fmt.Errorf("could not find any frame under: %v", path)
+			}
+
+			var entries []frameCheckEntry
+			for _, file := range files {
+				frame, err := loadFrameDSL(file)
+				if err != nil {
+					return err
+				}
+
+				frame, err = expandFrameIncludes(frame, fileutil.GetFileDir(file))
+				if err != nil {
+					return fmt.Errorf("%s: %v", file, err)
+				}
+
+				entries = append(entries, frameCheckEntry{
+					File:  file,
+					Frame: frame,
+					Links: findFrameRouteLinks(frame, routeProperties),
+				})
+			}
+
+			errorCount := 0
+			warningCount := 0
+			for _, issue := range checkFrames(entries) {
+				if issue.Severity == lintSeverityError {
+					errorCount++
+				} else {
+					warningCount++
+				}
+				if issue.File == "" {
+					fmt.Printf("%s: %s\n", issue.Severity, issue.Message)
+				} else {
+					fmt.Printf("%s: %s %s: %s\n", issue.File, issue.Severity, issue.Path, issue.Message)
+				}
+			}
+
+			if errorCount > 0 {
+				return fmt.Errorf("%v errors and %v warnings found", errorCount, warningCount)
+			}
+
+			fmt.Printf("%v frames checked, %v warnings found \n", len(files), warningCount)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frames directory path")
+	cmd.Flags().StringSliceVar(&routeProperties, "route-property", []string{}, "Trigger property keys holding routes, defaults to every key containing route")
+	_ = cmd.MarkFlagRequired("path")
+
+	return cmd
+}
+
+func searchCommand() *cobra.Command {
+	var path string
+	var filter frameSearchFilter
+	var jsonOutput bool
+	cmd := &cobra.Command{
+		Use:   "search",
+		Short: "Search blocks and triggers across frames",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if filter.isEmpty() {
+				return fmt.Errorf("please provide at least one of --keyType, --property, --variable, --event or --trigger")
+			}
+
+			files, err := findFrameFiles(path)
+			if err != nil {
+				return err
+			}
+
+			results := []frameSearchResult{}
+			for _, file := range files {
+				fileResults, err := searchFrameFile(file, filter)
+				if err != nil {
+					return err
+				}
+				results = append(results, fileResults...)
+			}
+
+			if jsonOutput {
+				resultsJson, err := json.MarshalIndent(results, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(resultsJson))
+				return nil
+			}
+
+			for _, result := range results {
+				if result.IncludedBy != "" {
+					fmt.Printf("%s:%v: %s %s (included by %s)\n", result.File, result.Line, result.Route, result.Path, result.IncludedBy)
+					continue
+				}
+				fmt.Printf("%s:%v: %s %s\n", result.File, result.Line, result.Route, result.Path)
+			}
+			fmt.Printf("%v matches found in %v frames \n", len(results), len(files))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file or directory path")
+	cmd.Flags().StringVar(&filter.KeyType, "keyType", "", "Block keyType")
+	cmd.Flags().StringVar(&filter.Property, "property", "", "Block property key")
+	cmd.Flags().StringVar(&filter.Variable, "variable", "", "Variable used by visibilityKey or data")
+	cmd.Flags().StringVar(&filter.Event, "event", "", "Block event")
+	cmd.Flags().StringVar(&filter.Trigger, "trigger", "", "Trigger keyType or name")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Print the matches as json")
+	_ = cmd.MarkFlagRequired("path")
+
+	return cmd
+}
+
+func mergeCommand() *cobra.Command {
+	var output string
+	var format string
+	cmd := &cobra.Command{
+		Use:   "merge base ours theirs",
+		Short: "Three-way merge of frame files, usable as a git merge driver",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			base, err := loadMergeBase(args[0])
+			if err != nil {
+				return err
+			}
+
+			ours, oursCompact, err := loadMergeFrame(args[1])
+			if err != nil {
+				return err
+			}
+
+			theirs, _, err := loadMergeFrame(args[2])
+			if err != nil {
+				return err
+			}
+
+			if output == "" {
+				output = args[1]
+			}
+
+			compact := oursCompact
+			switch {
+			case format == mergeFormatJSON:
+				compact = false
+			case format == mergeFormatCompact:
+				compact = true
+			case format != "":
+				return fmt.Errorf("unsupported format %v, please use %s or %s", format, mergeFormatJSON, mergeFormatCompact)
+			case isCompactFrameFile(output):
+				compact = true
+			case filepath.Ext(output) == ".json":
+				compact = false
+			}
+
+			merged, conflicts, err := mergeFrames(base, ours, theirs, compact)
+			if err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(output, merged, 0644); err != nil {
+				return fmt.Errorf("failed to write merged frame: %v", err)
+			}
+
+			if conflicts > 0 {
+				return fmt.Errorf("%v conflicts found, please resolve them in %s", conflicts, output)
+			}
+
+			fmt.Printf("Frame successfully merged into %s \n", output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file, defaults to the ours file")
+	cmd.Flags().StringVarP(&format, "format", "f", "", "Output syntax, json or nbf, defaults to the output file extension or the syntax of ours")
+
+	return cmd
+}
+
+func historyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history route",
+		Short: "List the local snapshots of a frame",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseFm, err := fileutil.NewFileManager(nil)
+			if err != nil {
+				return err
+			}
+
+			project, err := projectModule.GetProject(*baseFm)
+			if err != nil {
+				return err
+			}
+
+			snapshots, err := listFrameSnapshots(frameHistoryDir(*baseFm, project.Id, normalizeRoute(args[0])))
+			if err != nil {
+				return err
+			}
+			if len(snapshots) == 0 {
+				return fmt.Errorf("could not find any snapshot for %v", args[0])
+			}
+
+			table := tablewriter.NewWriter(os.Stdout)
+			table.Header([]string{"Snapshot", "Kind", "Time"})
+			for _, snapshot := range snapshots {
+				table.Append([]string{
+					snapshot.Id,
+					snapshot.Kind,
+					snapshot.Time.Format("2006-01-02 15:04:05"),
+				})
+			}
+			table.Render()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func rollbackCommand() *cobra.Command {
+	var snapshotId string
+	cmd := &cobra.Command{
+		Use:   "rollback route",
+		Short: "Push a snapshot from the local history",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseFm, err := fileutil.NewFileManager(nil)
+			if err != nil {
+				return err
+			}
+
+			region, err := regionModule.GetRegion(*baseFm)
+			if err != nil {
+				return err
+			}
+
+			auth, err := authModule.AuthGet(*baseFm)
+			if err != nil {
+				return err
+			}
+
+			project, err := projectModule.GetProject(*baseFm)
+			if err != nil {
+				return err
+			}
+
+			historyDir := frameHistoryDir(*baseFm, project.Id, normalizeRoute(args[0]))
+			jsonDSL, err := loadFrameSnapshot(historyDir, snapshotId)
+			if err != nil {
+				return err
+			}
+
+			output, err := generateFrame(jsonDSL, frameGenerateOptions{BaseDir: "."})
+			if err != nil {
+				return err
+			}
+
+			err = pushFrameWithHistory(output, jsonDSL.Schema, historyDir, region.Url, auth.AccessToken, project.APIKeys[0].APIKey)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Frame successfully rolled back to %s \n", snapshotId)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&snapshotId, "to", "", "Snapshot to restore, see frame history")
+	_ = cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func statsCommand() *cobra.Command {
+	var path string
+	var limits frameStatsLimits
+	var generateFlags frameGenerateFlags
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Report the size and complexity of frames",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := findFrameFiles(path)
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				return fmt.Errorf("could not find any frame under: %v", path)
+			}
+
+			options, err := generateFlags.options("")
+			if err != nil {
+				return err
+			}
+
+			failed := 0
+			for _, file := range files {
+				frame, err := loadFrameModel(file, options)
+				if err != nil {
+					return fmt.Errorf("%s: %v", file, err)
+				}
+
+				stats, err := collectFrameStats(frame)
+				if err != nil {
+					return err
+				}
+
+				config, err := loadFrameProjectConfig(fileutil.GetFileDir(file))
+				if err != nil {
+					return err
+				}
+
+				fmt.Printf("%s (%s)\n", file, frame.Route)
+				fmt.Print(renderFrameStats(stats))
+				exceeded := config.Stats.merge(limits).check(stats)
+				for _, message := range exceeded {
+					fmt.Printf("  error: %s\n", message)
+				}
+				if len(exceeded) > 0 {
+					failed++
+				}
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%v of %v frames exceed the limits", failed, len(files))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file or directory path")
+	cmd.Flags().IntVar(&limits.MaxBlocks, "max-blocks", 0, "Maximum number of blocks")
+	cmd.Flags().IntVar(&limits.MaxDepth, "max-depth", 0, "Maximum nesting depth of blocks")
+	cmd.Flags().IntVar(&limits.MaxActions, "max-actions", 0, "Maximum number of actions")
+	cmd.Flags().IntVar(&limits.MaxTriggers, "max-triggers", 0, "Maximum number of triggers")
+	cmd.Flags().IntVar(&limits.MaxVariables, "max-variables", 0, "Maximum number of variables")
+	cmd.Flags().IntVar(&limits.MaxPayloadSize, "max-payload-size", 0, "Maximum payload size in bytes")
+	generateFlags.bind(cmd)
+	_ = cmd.MarkFlagRequired("path")
+
+	return cmd
+}
+
+func localeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locale",
+		Short: "Manage the locale bundles used by @string/key values",
+	}
+
+	cmd.AddCommand(localeReportCommand())
+	cmd.AddCommand(localeExtractCommand())
+
+	return cmd
+}
+
+func localeReportCommand() *cobra.Command {
+	var path string
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report missing translations per locale",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := findFrameFiles(path)
+			if err != nil {
+				return err
+			}
+
+			localeDir, err := findLocaleDir(path)
+			if err != nil {
+				return err
+			}
+			locales, err := listLocales(localeDir)
+			if err != nil {
+				return err
+			}
+			if len(locales) == 0 {
+				return fmt.Errorf("could not find any locale file under: %v", localeDir)
+			}
+
+			var refs []string
+			for _, file := range files {
+				frame, err := loadFrameDSL(file)
+				if err != nil {
+					return err
+				}
+
+				frame, err = expandFrameIncludes(frame, fileutil.GetFileDir(file))
+				if err != nil {
+					return fmt.Errorf("%s: %v", file, err)
+				}
+				refs = append(refs, findFrameStringRefs(frame)...)
+			}
+
+			bundles := make(map[string]map[string]string)
+			for _, locale := range locales {
+				bundle, err := loadLocaleBundle(filepath.Join(localeDir, locale+".json"))
+				if err != nil {
+					return err
+				}
+				bundles[locale] = bundle
+			}
+
+			missing := findMissingTranslations(bundles, refs)
+			missingCount := 0
+			for _, locale := range locales {
+				for _, key := range missing[locale] {
+					fmt.Printf("%s: missing %s\n", locale, key)
+				}
+				missingCount += len(missing[locale])
+			}
+			for _, locale := range locales {
+				fmt.Printf("%s: %v missing\n", locale, len(missing[locale]))
+			}
+
+			if missingCount > 0 {
+				return fmt.Errorf("%v missing translations found", missingCount)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file or directory path")
+	_ = cmd.MarkFlagRequired("path")
+
+	return cmd
+}
+
+func localeExtractCommand() *cobra.Command {
+	var path string
+	var locale string
+	var output string
+	var properties []string
+	var replace bool
+	cmd := &cobra.Command{
+		Use:   "extract",
+		Short: "Extract literal strings of frames into a locale bundle",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := findFrameFiles(path)
+			if err != nil {
+				return err
+			}
+
+			if output == "" {
+				localeDir, err := findLocaleDir(path)
+				if err != nil {
+					return err
+				}
+				output = filepath.Join(localeDir, locale+".json")
+			}
+
+			bundle := make(map[string]string)
+			if _, err := os.Stat(output); err == nil {
+				bundle, err = loadLocaleBundle(output)
+				if err != nil {
+					return err
+				}
+			}
+
+			extractedCount := 0
+			var fragments []string
+			for _, file := range files {
+				frame, err := loadFrameDSL(file)
+				if err != nil {
+					return err
+				}
+				fragments = append(fragments, findBlockIncludes(frame.Blocks, fileutil.GetFileDir(file))...)
+
+				frame, extracted := extractFrameStrings(frame, properties, bundle)
+				for _, item := range extracted {
+					fmt.Printf("%s: %s -> %s\n", file, item.Path, item.Key)
+				}
+				extractedCount += len(extracted)
+
+				if replace && len(extracted) > 0 {
+					if err := saveFrameFile(file, frame); err != nil {
+						return err
+					}
+				}
+			}
+
+			seenFragments := make(map[string]bool)
+			for i := 0; i < len(fragments); i++ {
+				fragmentPath := fragments[i]
+				if seenFragments[fragmentPath] {
+					continue
+				}
+				seenFragments[fragmentPath] = true
+
+				fragment, err := loadBlockFragmentFile(fragmentPath)
+				if err != nil {
+					fmt.Printf("%s: skipped, %v\n", fragmentPath, err)
+					continue
+				}
+				fragments = append(fragments, findBlockIncludes([]BlockDSLModel{fragment}, fileutil.GetFileDir(fragmentPath))...)
+
+				name := strings.TrimSuffix(filepath.Base(fragmentPath), filepath.Ext(fragmentPath))
+				fragment, extracted := extractBlockFragmentStrings(fragment, name, properties, bundle)
+				for _, item := range extracted {
+					fmt.Printf("%s: %s -> %s\n", fragmentPath, item.Path, item.Key)
+				}
+				extractedCount += len(extracted)
+
+				if replace && len(extracted) > 0 {
+					if err := saveBlockFragmentFile(fragmentPath, fragment); err != nil {
+						return err
+					}
+				}
+			}
+
+			if err := saveLocaleBundle(output, bundle); err != nil {
+				return err
+			}
+			fmt.Printf("%v strings extracted into %s \n", extractedCount, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file or directory path")
+	cmd.Flags().StringVar(&locale, "locale", "en", "Locale of the extracted strings")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Bundle file path, defaults to the locale file of the project")
+	cmd.Flags().StringSliceVar(&properties, "property", []string{"text", "title", "label", "placeholder", "hint", "message"}, "Property keys holding translatable text")
+	cmd.Flags().BoolVar(&replace, "replace", false, "Replace the extracted literals in the frames with @string/key values")
+	_ = cmd.MarkFlagRequired("path")
+
+	return cmd
+}
+
+func convertCommand() *cobra.Command {
+	var path string
+	var output string
+	cmd := &cobra.Command{
+		Use:   "convert",
+		Short: "Convert frames between the JSON and the compact .nbf syntax",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			frame, err := loadFrameDSL(path)
+			if err != nil {
+				return err
+			}
+
+			if output == "" {
+				extension := compactFrameExtension
+				if isCompactFrameFile(path) {
+					extension = ".json"
+				}
+				output = strings.TrimSuffix(path, filepath.Ext(path)) + extension
+			}
+			if isCompactFrameFile(path) == isCompactFrameFile(output) {
+				return fmt.Errorf("output %s must use the other syntax than %s", output, path)
+			}
+
+			if err := saveFrameFile(output, frame); err != nil {
+				return err
+			}
+			fmt.Printf("Frame converted into %s \n", output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "path", "p", "", "Frame file path, .json or .nbf")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file path, defaults to the frame path with the other extension")
 	_ = cmd.MarkFlagRequired("path")
 
 	return cmd
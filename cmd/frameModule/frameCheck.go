@@ -0,0 +1,157 @@
+package frameModule
+
+import (
+	"fmt"
+	"strings"
+)
+
+type frameCheckIssue struct {
+	Severity string
+	File     string
+	Path     string
+	Message  string
+}
+
+type frameRouteLink struct {
+	Path  string
+	Route string
+}
+
+type frameCheckEntry struct {
+	File  string
+	Frame FrameDSLModel
+	Links []frameRouteLink
+}
+
+func checkFrames(entries []frameCheckEntry) []frameCheckIssue {
+	var issues []frameCheckIssue
+
+	routeFiles := make(map[string][]string)
+	var starters []frameCheckEntry
+	for _, entry := range entries {
+		route := normalizeRoute(entry.Frame.Route)
+		routeFiles[route] = append(routeFiles[route], entry.File)
+		if entry.Frame.IsStarter {
+			starters = append(starters, entry)
+		}
+	}
+
+	for _, entry := range entries {
+		files := routeFiles[normalizeRoute(entry.Frame.Route)]
+		if len(files) > 1 {
+			var others []string
+			for _, file := range files {
+				if file != entry.File {
+					others = append(others, file)
+				}
+			}
+			issues = append(issues, frameCheckIssue{Severity: lintSeverityError, File: entry.File, Path: "route", Message: fmt.Sprintf("duplicate route %s, also used by %s", entry.Frame.Route, strings.Join(others, ", "))})
+		}
+	}
+
+	switch len(starters) {
+	case 0:
+		issues = append(issues, frameCheckIssue{Severity: lintSeverityError, Message: "no frame is marked as starter"})
+	case 1:
+	default:
+		for _, starter := range starters {
+			issues = append(issues, frameCheckIssue{Severity: lintSeverityError, File: starter.File, Path: "isStarter", Message: fmt.Sprintf("%v frames are marked as starter, only one is allowed", len(starters))})
+		}
+	}
+
+	for _, entry := range entries {
+		for _, link := range entry.Links {
+			if len(findLinkedFrames(entries, link.Route)) == 0 {
+				issues = append(issues, frameCheckIssue{Severity: lintSeverityError, File: entry.File, Path: link.Path, Message: fmt.Sprintf("route %s does not match any frame", link.Route)})
+			}
+		}
+	}
+
+	if len(starters) > 0 {
+		reached := make(map[string]bool)
+		var queue []frameCheckEntry
+		for _, starter := range starters {
+			reached[starter.File] = true
+			queue = append(queue, starter)
+		}
+		for len(queue) > 0 {
+			entry := queue[0]
+			queue = queue[1:]
+			for _, link := range entry.Links {
+				for _, linked := range findLinkedFrames(entries, link.Route) {
+					if !reached[linked.File] {
+						reached[linked.File] = true
+						queue = append(queue, linked)
+					}
+				}
+			}
+		}
+
+		for _, entry := range entries {
+			if !reached[entry.File] {
+				issues = append(issues, frameCheckIssue{Severity: lintSeverityWarning, File: entry.File, Path: "route", Message: fmt.Sprintf("frame %s is not reachable from the starter frame", entry.Frame.Route)})
+			}
+		}
+	}
+
+	return issues
+}
+
+func findLinkedFrames(entries []frameCheckEntry, route string) []frameCheckEntry {
+	var linked []frameCheckEntry
+	for _, entry := range entries {
+		if matchRoute(entry.Frame.Route, route) {
+			linked = append(linked, entry)
+		}
+	}
+	return linked
+}
+
+func findFrameRouteLinks(frame FrameDSLModel, routeProperties []string) []frameRouteLink {
+	var links []frameRouteLink
+	collectBlockRouteLinks(frame.Blocks, "", routeProperties, &links)
+	return links
+}
+
+func collectBlockRouteLinks(blocks []BlockDSLModel, parentPath string, routeProperties []string, links *[]frameRouteLink) {
+	for index, block := range blocks {
+		path := fmt.Sprintf("%sblocks[%d](%s)", parentPath, index, block.Key)
+		for actionIndex, action := range block.Actions {
+			actionPath := fmt.Sprintf("%s.actions[%d](%s).", path, actionIndex, action.Event)
+			collectTriggerRouteLinks(action.Triggers, actionPath, routeProperties, links)
+		}
+		collectBlockRouteLinks(block.Blocks, path+".", routeProperties, links)
+	}
+}
+
+func collectTriggerRouteLinks(triggers []ActionTriggerDSLModel, parentPath string, routeProperties []string, links *[]frameRouteLink) {
+	for index, trigger := range triggers {
+		path := fmt.Sprintf("%striggers[%d](%s)", parentPath, index, trigger.Name)
+		for propertyIndex, property := range trigger.Properties {
+			if !isRouteProperty(property.Key, routeProperties) {
+				continue
+			}
+			value := strings.TrimSpace(property.Value)
+			if !strings.HasPrefix(value, "/") || placeholderPattern.MatchString(value) {
+				continue
+			}
+			*links = append(*links, frameRouteLink{
+				Path:  fmt.Sprintf("%s.properties[%d](%s)", path, propertyIndex, property.Key),
+				Route: value,
+			})
+		}
+		collectTriggerRouteLinks(trigger.Triggers, path+".", routeProperties, links)
+	}
+}
+
+func isRouteProperty(key string, routeProperties []string) bool {
+	if len(routeProperties) == 0 {
+		return strings.Contains(strings.ToLower(key), "route")
+	}
+	for _, routeProperty := range routeProperties {
+		if routeProperty == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,615 @@
+package frameModule
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const compactFrameExtension = ".nbf"
+
+var compactBarePattern = regexp.MustCompile(`^[^\s"=#\\]+$`)
+
+type compactToken struct {
+	Name      string
+	Value     string
+	Attribute bool
+}
+
+type compactNode struct {
+	Line     int
+	Indent   int
+	Tokens   []compactToken
+	Children []*compactNode
+}
+
+func isCompactFrameFile(path string) bool {
+	return filepath.Ext(path) == compactFrameExtension
+}
+
+func parseCompactNodes(content string) ([]*compactNode, error) {
+	root := &compactNode{Indent: -1}
+	stack := []*compactNode{root}
+
+	for index, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		lineNumber := index + 1
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		indentation := line[:len(line)-len(trimmed)]
+		if strings.Contains(indentation, "\t") {
+			return nil, fmt.Errorf("line %v: use spaces for the indentation", lineNumber)
+		}
+
+		tokens, err := tokenizeCompactLine(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("line %v: %v", lineNumber, err)
+		}
+		if tokens[0].Attribute {
+			return nil, fmt.Errorf("line %v: a line must start with a statement", lineNumber)
+		}
+
+		node := &compactNode{Line: lineNumber, Indent: len(indentation), Tokens: tokens}
+		for stack[len(stack)-1].Indent >= node.Indent {
+			stack = stack[:len(stack)-1]
+		}
+
+		parent := stack[len(stack)-1]
+		if parent == root && node.Indent != 0 {
+			return nil, fmt.Errorf("line %v: unexpected indentation", lineNumber)
+		}
+		if len(parent.Children) > 0 && parent.Children[0].Indent != node.Indent {
+			return nil, fmt.Errorf("line %v: inconsistent indentation", lineNumber)
+		}
+
+		parent.Children = append(parent.Children, node)
+		stack = append(stack, node)
+	}
+	return root.Children, nil
+}
+
+func tokenizeCompactLine(line string) ([]compactToken, error) {
+	var tokens []compactToken
+	for i := 0; i < len(line); {
+		if line[i] == ' ' || line[i] == '\t' {
+			i++
+			continue
+		}
+
+		if line[i] == '"' {
+			value, next, err := readCompactQuoted(line, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, compactToken{Value: value})
+			i = next
+			continue
+		}
+
+		start := i
+		for i < len(line) && line[i] != ' ' && line[i] != '\t' && line[i] != '=' && line[i] != '"' {
+			i++
+		}
+		word := line[start:i]
+
+		if i < len(line) && line[i] == '"' {
+			return nil, fmt.Errorf("unexpected quote after %q", word)
+		}
+		if i >= len(line) || line[i] != '=' {
+			tokens = append(tokens, compactToken{Value: word})
+			continue
+		}
+
+		if word == "" {
+			return nil, errors.New("missing attribute name before =")
+		}
+		i++
+		if i < len(line) && line[i] == '"' {
+			value, next, err := readCompactQuoted(line, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, compactToken{Name: word, Value: value, Attribute: true})
+			i = next
+			continue
+		}
+
+		start = i
+		for i < len(line) && line[i] != ' ' && line[i] != '\t' {
+			i++
+		}
+		tokens = append(tokens, compactToken{Name: word, Value: line[start:i], Attribute: true})
+	}
+	return tokens, nil
+}
+
+func readCompactQuoted(line string, start int) (string, int, error) {
+	for i := start + 1; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '"':
+			value, err := strconv.Unquote(line[start : i+1])
+			if err != nil {
+				return "", 0, fmt.Errorf("invalid quoted value %s", line[start:i+1])
+			}
+			return value, i + 1, nil
+		}
+	}
+	return "", 0, errors.New("missing closing quote")
+}
+
+func (node *compactNode) statement() string {
+	return node.Tokens[0].Value
+}
+
+func (node *compactNode) arguments(minimum int, maximum int, attributeNames ...string) ([]string, map[string]string, error) {
+	var values []string
+	attributes := make(map[string]string)
+	for _, token := range node.Tokens[1:] {
+		if !token.Attribute {
+			values = append(values, token.Value)
+			continue
+		}
+		if !containsString(attributeNames, token.Name) {
+			return nil, nil, fmt.Errorf("line %v: unknown attribute %s for %s", node.Line, token.Name, node.statement())
+		}
+		if _, found := attributes[token.Name]; found {
+			return nil, nil, fmt.Errorf("line %v: duplicate attribute %s", node.Line, token.Name)
+		}
+		attributes[token.Name] = token.Value
+	}
+
+	if len(values) < minimum || len(values) > maximum {
+		return nil, nil, fmt.Errorf("line %v: %s expects %s", node.Line, node.statement(), compactArgumentCount(minimum, maximum))
+	}
+	for len(values) < maximum {
+		values = append(values, "")
+	}
+	return values, attributes, nil
+}
+
+func (node *compactNode) noChildren() error {
+	if len(node.Children) > 0 {
+		return fmt.Errorf("line %v: %s can not have nested lines", node.Children[0].Line, node.statement())
+	}
+	return nil
+}
+
+func compactArgumentCount(minimum int, maximum int) string {
+	if minimum == maximum {
+		return fmt.Sprintf("%v values", minimum)
+	}
+	return fmt.Sprintf("%v to %v values", minimum, maximum)
+}
+
+func compactInt(node *compactNode, name string, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("line %v: %s must be a number", node.Line, name)
+	}
+	return number, nil
+}
+
+type compactParser struct {
+	lines map[string]int
+}
+
+func parseCompactFrame(content []byte) (FrameDSLModel, map[string]int, error) {
+	nodes, err := parseCompactNodes(string(content))
+	if err != nil {
+		return FrameDSLModel{}, nil, err
+	}
+
+	parser := &compactParser{lines: make(map[string]int)}
+	frame := FrameDSLModel{
+		Variables: []VariableDSLModel{},
+		Blocks:    []BlockDSLModel{},
+	}
+	hasFrame := false
+
+	for _, node := range nodes {
+		switch node.statement() {
+		case "schema":
+			values, _, err := node.arguments(1, 1)
+			if err != nil {
+				return FrameDSLModel{}, nil, err
+			}
+			frame.Schema = values[0]
+		case "frame":
+			if hasFrame {
+				return FrameDSLModel{}, nil, fmt.Errorf("line %v: duplicate frame statement", node.Line)
+			}
+			values, attributes, err := node.arguments(0, 1, "route", "type", "starter")
+			if err != nil {
+				return FrameDSLModel{}, nil, err
+			}
+			frame.Name = values[0]
+			frame.Route = attributes["route"]
+			frame.Type = attributes["type"]
+			if starter, found := attributes["starter"]; found {
+				frame.IsStarter, err = strconv.ParseBool(starter)
+				if err != nil {
+					return FrameDSLModel{}, nil, fmt.Errorf("line %v: starter must be true or false", node.Line)
+				}
+			}
+			hasFrame = true
+		case "var":
+			values, _, err := node.arguments(2, 3)
+			if err != nil {
+				return FrameDSLModel{}, nil, err
+			}
+			parser.lines["/variables/"+strconv.Itoa(len(frame.Variables))] = node.Line
+			frame.Variables = append(frame.Variables, VariableDSLModel{Key: values[0], Type: values[1], Value: values[2]})
+		case "block", "include":
+			block, err := parser.parseBlock(node, "/blocks/"+strconv.Itoa(len(frame.Blocks)))
+			if err != nil {
+				return FrameDSLModel{}, nil, err
+			}
+			frame.Blocks = append(frame.Blocks, block)
+			continue
+		default:
+			return FrameDSLModel{}, nil, fmt.Errorf("line %v: unknown statement %s", node.Line, node.statement())
+		}
+
+		if err := node.noChildren(); err != nil {
+			return FrameDSLModel{}, nil, err
+		}
+	}
+
+	if !hasFrame {
+		return FrameDSLModel{}, nil, errors.New("missing frame statement")
+	}
+	return frame, parser.lines, nil
+}
+
+func parseCompactBlock(content []byte) (BlockDSLModel, error) {
+	block, _, err := parseCompactBlockLines(content)
+	return block, err
+}
+
+func parseCompactBlockLines(content []byte) (BlockDSLModel, map[string]int, error) {
+	nodes, err := parseCompactNodes(string(content))
+	if err != nil {
+		return BlockDSLModel{}, nil, err
+	}
+	if len(nodes) != 1 || (nodes[0].statement() != "block" && nodes[0].statement() != "include") {
+		return BlockDSLModel{}, nil, errors.New("a fragment must contain exactly one block or include statement")
+	}
+
+	parser := &compactParser{lines: make(map[string]int)}
+	block, err := parser.parseBlock(nodes[0], "")
+	return block, parser.lines, err
+}
+
+func (parser *compactParser) parseBlock(node *compactNode, pointer string) (BlockDSLModel, error) {
+	parser.lines[pointer] = node.Line
+	if node.statement() == "include" {
+		return parser.parseInclude(node)
+	}
+
+	values, attributes, err := node.arguments(2, 2, "slot", "visibility", "version")
+	if err != nil {
+		return BlockDSLModel{}, err
+	}
+	version, err := compactInt(node, "version", attributes["version"])
+	if err != nil {
+		return BlockDSLModel{}, err
+	}
+
+	block := BlockDSLModel{
+		KeyType:            values[0],
+		Key:                values[1],
+		VisibilityKey:      attributes["visibility"],
+		Slot:               attributes["slot"],
+		IntegrationVersion: version,
+		Data:               []BlockDataDSLModel{},
+		Properties:         []BlockPropertyDSLModel{},
+		Slots:              []BlockSlotDSLModel{},
+		Blocks:             []BlockDSLModel{},
+		Actions:            []ActionDSLModel{},
+	}
+	if block.Slot == "" {
+		block.Slot = "null"
+	}
+
+	for _, child := range node.Children {
+		switch child.statement() {
+		case "prop":
+			values, attributes, err := child.arguments(2, 3, "mobile", "tablet", "desktop")
+			if err != nil {
+				return BlockDSLModel{}, err
+			}
+			property := BlockPropertyDSLModel{Key: values[0], Type: values[1]}
+			property.ValueMobile = compactBreakpointValue(attributes, "mobile", values[2])
+			property.ValueTablet = compactBreakpointValue(attributes, "tablet", values[2])
+			property.ValueDesktop = compactBreakpointValue(attributes, "desktop", values[2])
+			parser.lines[pointer+"/properties/"+strconv.Itoa(len(block.Properties))] = child.Line
+			block.Properties = append(block.Properties, property)
+		case "data":
+			values, _, err := child.arguments(2, 3)
+			if err != nil {
+				return BlockDSLModel{}, err
+			}
+			parser.lines[pointer+"/data/"+strconv.Itoa(len(block.Data))] = child.Line
+			block.Data = append(block.Data, BlockDataDSLModel{Key: values[0], Type: values[1], Value: values[2]})
+		case "slot":
+			values, _, err := child.arguments(1, 1)
+			if err != nil {
+				return BlockDSLModel{}, err
+			}
+			block.Slots = append(block.Slots, BlockSlotDSLModel{Slot: values[0]})
+		case "on":
+			action, err := parser.parseAction(child, pointer+"/actions/"+strconv.Itoa(len(block.Actions)))
+			if err != nil {
+				return BlockDSLModel{}, err
+			}
+			block.Actions = append(block.Actions, action)
+			continue
+		case "block", "include":
+			nested, err := parser.parseBlock(child, pointer+"/blocks/"+strconv.Itoa(len(block.Blocks)))
+			if err != nil {
+				return BlockDSLModel{}, err
+			}
+			block.Blocks = append(block.Blocks, nested)
+			continue
+		default:
+			return BlockDSLModel{}, fmt.Errorf("line %v: unknown statement %s in block", child.Line, child.statement())
+		}
+
+		if err := child.noChildren(); err != nil {
+			return BlockDSLModel{}, err
+		}
+	}
+	return block, nil
+}
+
+func (parser *compactParser) parseInclude(node *compactNode) (BlockDSLModel, error) {
+	values, attributes, err := node.arguments(1, 1, "prefix", "slot")
+	if err != nil {
+		return BlockDSLModel{}, err
+	}
+
+	include := BlockDSLModel{
+		Include: values[0],
+		Prefix:  attributes["prefix"],
+		Slot:    attributes["slot"],
+	}
+	for _, child := range node.Children {
+		if child.statement() != "param" {
+			return BlockDSLModel{}, fmt.Errorf("line %v: unknown statement %s in include", child.Line, child.statement())
+		}
+		values, _, err := child.arguments(2, 2)
+		if err != nil {
+			return BlockDSLModel{}, err
+		}
+		if err := child.noChildren(); err != nil {
+			return BlockDSLModel{}, err
+		}
+		if include.Params == nil {
+			include.Params = make(map[string]string)
+		}
+		include.Params[values[0]] = values[1]
+	}
+	return include, nil
+}
+
+func (parser *compactParser) parseAction(node *compactNode, pointer string) (ActionDSLModel, error) {
+	parser.lines[pointer] = node.Line
+	values, attributes, err := node.arguments(1, 1, "key")
+	if err != nil {
+		return ActionDSLModel{}, err
+	}
+
+	action := ActionDSLModel{
+		Key:      attributes["key"],
+		Event:    values[0],
+		Triggers: []ActionTriggerDSLModel{},
+	}
+	for _, child := range node.Children {
+		if child.statement() != "trigger" {
+			return ActionDSLModel{}, fmt.Errorf("line %v: unknown statement %s in action", child.Line, child.statement())
+		}
+		trigger, err := parser.parseTrigger(child, pointer+"/triggers/"+strconv.Itoa(len(action.Triggers)))
+		if err != nil {
+			return ActionDSLModel{}, err
+		}
+		action.Triggers = append(action.Triggers, trigger)
+	}
+	return action, nil
+}
+
+func (parser *compactParser) parseTrigger(node *compactNode, pointer string) (ActionTriggerDSLModel, error) {
+	parser.lines[pointer] = node.Line
+	values, attributes, err := node.arguments(2, 2, "then", "version")
+	if err != nil {
+		return ActionTriggerDSLModel{}, err
+	}
+	version, err := compactInt(node, "version", attributes["version"])
+	if err != nil {
+		return ActionTriggerDSLModel{}, err
+	}
+
+	trigger := ActionTriggerDSLModel{
+		KeyType:            values[0],
+		Name:               values[1],
+		Then:               attributes["then"],
+		IntegrationVersion: version,
+		Properties:         []TriggerPropertyDSLModel{},
+		Data:               []TriggerDataDSLModel{},
+		Triggers:           []ActionTriggerDSLModel{},
+	}
+	if trigger.Then == "" {
+		trigger.Then = "END"
+	}
+
+	for _, child := range node.Children {
+		switch child.statement() {
+		case "prop":
+			values, _, err := child.arguments(2, 3)
+			if err != nil {
+				return ActionTriggerDSLModel{}, err
+			}
+			parser.lines[pointer+"/properties/"+strconv.Itoa(len(trigger.Properties))] = child.Line
+			trigger.Properties = append(trigger.Properties, TriggerPropertyDSLModel{Key: values[0], Type: values[1], Value: values[2]})
+		case "data":
+			values, _, err := child.arguments(2, 3)
+			if err != nil {
+				return ActionTriggerDSLModel{}, err
+			}
+			parser.lines[pointer+"/data/"+strconv.Itoa(len(trigger.Data))] = child.Line
+			trigger.Data = append(trigger.Data, TriggerDataDSLModel{Key: values[0], Type: values[1], Value: values[2]})
+		case "trigger":
+			nested, err := parser.parseTrigger(child, pointer+"/triggers/"+strconv.Itoa(len(trigger.Triggers)))
+			if err != nil {
+				return ActionTriggerDSLModel{}, err
+			}
+			trigger.Triggers = append(trigger.Triggers, nested)
+			continue
+		default:
+			return ActionTriggerDSLModel{}, fmt.Errorf("line %v: unknown statement %s in trigger", child.Line, child.statement())
+		}
+
+		if err := child.noChildren(); err != nil {
+			return ActionTriggerDSLModel{}, err
+		}
+	}
+	return trigger, nil
+}
+
+func compactBreakpointValue(attributes map[string]string, breakpoint string, value string) string {
+	if override, found := attributes[breakpoint]; found {
+		return override
+	}
+	return value
+}
+
+func printCompactFrame(frame FrameDSLModel) []byte {
+	var builder strings.Builder
+
+	if frame.Schema != "" {
+		builder.WriteString("schema " + compactValue(frame.Schema) + "\n")
+	}
+
+	line := "frame " + compactValue(frame.Name) + " route=" + compactValue(frame.Route)
+	if frame.Type != "" {
+		line += " type=" + compactValue(frame.Type)
+	}
+	if frame.IsStarter {
+		line += " starter=true"
+	}
+	builder.WriteString(line + "\n")
+
+	for _, variable := range frame.Variables {
+		builder.WriteString(fmt.Sprintf("var %s %s %s\n", compactValue(variable.Key), compactValue(variable.Type), compactValue(variable.Value)))
+	}
+	for _, block := range frame.Blocks {
+		printCompactBlock(&builder, block, "")
+	}
+	return []byte(builder.String())
+}
+
+func printCompactBlock(builder *strings.Builder, block BlockDSLModel, indent string) {
+	if block.Include != "" {
+		line := indent + "include " + compactValue(block.Include)
+		if block.Prefix != "" {
+			line += " prefix=" + compactValue(block.Prefix)
+		}
+		if block.Slot != "" {
+			line += " slot=" + compactValue(block.Slot)
+		}
+		builder.WriteString(line + "\n")
+
+		var names []string
+		for name := range block.Params {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			builder.WriteString(fmt.Sprintf("%s  param %s %s\n", indent, compactValue(name), compactValue(block.Params[name])))
+		}
+		return
+	}
+
+	line := fmt.Sprintf("%sblock %s %s", indent, compactValue(block.KeyType), compactValue(block.Key))
+	if block.Slot != "" && block.Slot != "null" {
+		line += " slot=" + compactValue(block.Slot)
+	}
+	if block.VisibilityKey != "" {
+		line += " visibility=" + compactValue(block.VisibilityKey)
+	}
+	if block.IntegrationVersion != 0 {
+		line += fmt.Sprintf(" version=%v", block.IntegrationVersion)
+	}
+	builder.WriteString(line + "\n")
+
+	childIndent := indent + "  "
+	for _, property := range block.Properties {
+		property = property.expandValue()
+		line := fmt.Sprintf("%sprop %s %s %s", childIndent, compactValue(property.Key), compactValue(property.Type), compactValue(property.ValueMobile))
+		if property.ValueTablet != property.ValueMobile {
+			line += " tablet=" + compactValue(property.ValueTablet)
+		}
+		if property.ValueDesktop != property.ValueMobile {
+			line += " desktop=" + compactValue(property.ValueDesktop)
+		}
+		builder.WriteString(line + "\n")
+	}
+	for _, data := range block.Data {
+		builder.WriteString(fmt.Sprintf("%sdata %s %s %s\n", childIndent, compactValue(data.Key), compactValue(data.Type), compactValue(data.Value)))
+	}
+	for _, slot := range block.Slots {
+		builder.WriteString(fmt.Sprintf("%sslot %s\n", childIndent, compactValue(slot.Slot)))
+	}
+	for _, action := range block.Actions {
+		line := fmt.Sprintf("%son %s", childIndent, compactValue(action.Event))
+		if action.Key != "" {
+			line += " key=" + compactValue(action.Key)
+		}
+		builder.WriteString(line + "\n")
+		for _, trigger := range action.Triggers {
+			printCompactTrigger(builder, trigger, childIndent+"  ")
+		}
+	}
+	for _, child := range block.Blocks {
+		printCompactBlock(builder, child, childIndent)
+	}
+}
+
+func printCompactTrigger(builder *strings.Builder, trigger ActionTriggerDSLModel, indent string) {
+	line := fmt.Sprintf("%strigger %s %s", indent, compactValue(trigger.KeyType), compactValue(trigger.Name))
+	if trigger.Then != "" && trigger.Then != "END" {
+		line += " then=" + compactValue(trigger.Then)
+	}
+	if trigger.IntegrationVersion != 0 {
+		line += fmt.Sprintf(" version=%v", trigger.IntegrationVersion)
+	}
+	builder.WriteString(line + "\n")
+
+	childIndent := indent + "  "
+	for _, property := range trigger.Properties {
+		builder.WriteString(fmt.Sprintf("%sprop %s %s %s\n", childIndent, compactValue(property.Key), compactValue(property.Type), compactValue(property.Value)))
+	}
+	for _, data := range trigger.Data {
+		builder.WriteString(fmt.Sprintf("%sdata %s %s %s\n", childIndent, compactValue(data.Key), compactValue(data.Type), compactValue(data.Value)))
+	}
+	for _, child := range trigger.Triggers {
+		printCompactTrigger(builder, child, childIndent)
+	}
+}
+
+func compactValue(value string) string {
+	if compactBarePattern.MatchString(value) {
+		return value
+	}
+	return strconv.Quote(value)
+}
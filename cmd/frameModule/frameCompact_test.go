@@ -0,0 +1,225 @@
+package frameModule
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const compactTestFrame = `{
+  "$schema": "https://example.com/schema.json",
+  "name": "Login \"main\" #1",
+  "route": "/login/{id}",
+  "type": "FRAME",
+  "isStarter": true,
+  "variables": [
+    {"key": "empty", "value": "", "type": "STRING"},
+    {"key": "hash", "value": "#ff0000", "type": "STRING"},
+    {"key": "multiline", "value": "first line\nsecond\tline", "type": "STRING"},
+    {"key": "quoted", "value": "say \"hi\" \\ back", "type": "STRING"},
+    {"key": "equals", "value": "a=b", "type": "STRING"},
+    {"key": "spaces", "value": "  padded  ", "type": "STRING"}
+  ],
+  "blocks": [
+    {
+      "keyType": "ROOT",
+      "key": "root",
+      "visibilityKey": "",
+      "slot": "null",
+      "integrationVersion": 0,
+      "data": [],
+      "properties": [],
+      "slots": [{"slot": "content"}],
+      "blocks": [
+        {
+          "keyType": "TEXT",
+          "key": "title text",
+          "visibilityKey": "visible",
+          "slot": "content",
+          "integrationVersion": 3,
+          "data": [
+            {"key": "text", "value": "title", "type": "STRING"},
+            {"key": "empty", "value": "", "type": "STRING"}
+          ],
+          "properties": [
+            {"key": "color", "value": "#000000", "type": "STRING"},
+            {"key": "size", "valueMobile": "12", "valueTablet": "14", "valueDesktop": "16", "type": "INT"},
+            {"key": "blank", "valueMobile": "", "valueTablet": "wide", "valueDesktop": "", "type": "STRING"},
+            {"key": "json", "valueMobile": "{\"a\": [1, 2]}", "valueTablet": "{\"a\": [1, 2]}", "valueDesktop": "{\"a\": [1, 2]}", "type": "STRING"}
+          ],
+          "slots": [],
+          "blocks": [],
+          "actions": [
+            {
+              "key": "title text",
+              "event": "onClick",
+              "triggers": [
+                {
+                  "keyType": "LOG",
+                  "then": "NEXT",
+                  "name": "log #1",
+                  "integrationVersion": 2,
+                  "properties": [{"key": "message", "value": "line\nbreak", "type": "STRING"}],
+                  "data": [{"key": "value", "value": "", "type": "STRING"}],
+                  "triggers": [
+                    {
+                      "keyType": "NAVIGATE",
+                      "then": "END",
+                      "name": "go",
+                      "integrationVersion": 0,
+                      "properties": [],
+                      "data": [],
+                      "triggers": []
+                    }
+                  ]
+                },
+                {
+                  "keyType": "TOAST",
+                  "then": "FAILURE",
+                  "name": "toast",
+                  "integrationVersion": 1,
+                  "properties": [],
+                  "data": [],
+                  "triggers": []
+                }
+              ]
+            }
+          ]
+        },
+        {
+          "$include": "fragments/header.nbf",
+          "prefix": "top_",
+          "slot": "content",
+          "params": {"title": "Hello \"world\"", "empty": "", "color": "#fff"}
+        },
+        {
+          "$include": "fragments/footer.json"
+        }
+      ],
+      "actions": []
+    }
+  ]
+}`
+
+func expandCompactTestValues(blocks []BlockDSLModel) {
+	for i := range blocks {
+		for j := range blocks[i].Properties {
+			blocks[i].Properties[j] = blocks[i].Properties[j].expandValue()
+		}
+		expandCompactTestValues(blocks[i].Blocks)
+	}
+}
+
+func TestCompactFrameRoundTrip(t *testing.T) {
+	var frame FrameDSLModel
+	if err := json.Unmarshal([]byte(compactTestFrame), &frame); err != nil {
+		t.Fatalf("failed to parse the test frame: %v", err)
+	}
+
+	printed := printCompactFrame(frame)
+	parsed, _, err := parseCompactFrame(printed)
+	if err != nil {
+		t.Fatalf("failed to parse the printed frame: %v\n%s", err, printed)
+	}
+
+	expandCompactTestValues(frame.Blocks)
+	expected, err := marshalFrameDSL(frame)
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual, err := marshalFrameDSL(parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(actual) != string(expected) {
+		t.Fatalf("round trip changed the frame\nnbf:\n%s\nexpected:\n%s\nactual:\n%s", printed, expected, actual)
+	}
+
+	if reprinted := printCompactFrame(parsed); string(reprinted) != string(printed) {
+		t.Fatalf("printing the parsed frame changed the nbf\nfirst:\n%s\nsecond:\n%s", printed, reprinted)
+	}
+}
+
+func TestCompactBlockRoundTrip(t *testing.T) {
+	var frame FrameDSLModel
+	if err := json.Unmarshal([]byte(compactTestFrame), &frame); err != nil {
+		t.Fatalf("failed to parse the test frame: %v", err)
+	}
+
+	for _, block := range frame.Blocks[0].Blocks {
+		var builder strings.Builder
+		printCompactBlock(&builder, block, "")
+
+		parsed, err := parseCompactBlock([]byte(builder.String()))
+		if err != nil {
+			t.Fatalf("failed to parse the printed block: %v\n%s", err, builder.String())
+		}
+
+		expandCompactTestValues([]BlockDSLModel{block})
+		expected, err := marshalBlockDSL(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual, err := marshalBlockDSL(parsed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(actual) != string(expected) {
+			t.Fatalf("round trip changed the block\nnbf:\n%s\nexpected:\n%s\nactual:\n%s", builder.String(), expected, actual)
+		}
+	}
+}
+
+func TestCompactValue(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: "plain", expected: "plain"},
+		{value: "", expected: `""`},
+		{value: "two words", expected: `"two words"`},
+		{value: "#comment", expected: `"#comment"`},
+		{value: "a=b", expected: `"a=b"`},
+		{value: `say "hi"`, expected: `"say \"hi\""`},
+		{value: "line\nbreak", expected: `"line\nbreak"`},
+		{value: `back\slash`, expected: `"back\\slash"`},
+	}
+
+	for _, test := range tests {
+		if actual := compactValue(test.value); actual != test.expected {
+			t.Errorf("compactValue(%q) = %s, expected %s", test.value, actual, test.expected)
+		}
+
+		tokens, err := tokenizeCompactLine("var " + compactValue(test.value))
+		if err != nil {
+			t.Errorf("failed to tokenize %q: %v", test.value, err)
+			continue
+		}
+		if len(tokens) != 2 || tokens[1].Attribute || tokens[1].Value != test.value {
+			t.Errorf("tokenizing %s gave %+v, expected %q", compactValue(test.value), tokens, test.value)
+		}
+	}
+}
+
+func TestParseCompactFrameErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		message string
+	}{
+		{name: "missing frame", content: "var a STRING x\n", message: "missing frame statement"},
+		{name: "unclosed quote", content: "frame \"Page route=/p\n", message: "line 1: missing closing quote"},
+		{name: "tab indentation", content: "frame Page route=/p\nblock ROOT root\n\tprop a STRING b\n", message: "line 3: use spaces for the indentation"},
+		{name: "unknown attribute", content: "frame Page route=/p color=red\n", message: "line 1: unknown attribute color for frame"},
+		{name: "invalid version", content: "frame Page route=/p\nblock ROOT root version=x\n", message: "line 2: version must be a number"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := parseCompactFrame([]byte(test.content))
+			if err == nil || err.Error() != test.message {
+				t.Fatalf("expected error %q, got %v", test.message, err)
+			}
+		})
+	}
+}
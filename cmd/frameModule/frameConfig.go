@@ -0,0 +1,126 @@
+package frameModule
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/nativeblocks/cli/library/fileutil"
+)
+
+const frameConfigFileName = "config.json"
+
+type frameProjectConfig struct {
+	FrameTypes map[string]frameTypeRule `json:"frameTypes"`
+	Stats      frameStatsLimits         `json:"stats"`
+}
+
+type frameTypeRule struct {
+	AllowStarter *bool    `json:"allowStarter"`
+	RoutePattern string   `json:"routePattern"`
+	RootKeyTypes []string `json:"rootKeyTypes"`
+}
+
+func defaultFrameTypeRules() map[string]frameTypeRule {
+	allowed := true
+	notAllowed := false
+	return map[string]frameTypeRule{
+		"FRAME":        {AllowStarter: &allowed},
+		"BOTTOM_SHEET": {AllowStarter: &notAllowed},
+		"DIALOG":       {AllowStarter: &notAllowed},
+	}
+}
+
+func loadFrameProjectConfig(frameDir string) (frameProjectConfig, error) {
+	projectDir := findProjectDir(frameDir)
+	if projectDir == "" {
+		return frameProjectConfig{}, nil
+	}
+
+	path := filepath.Join(projectDir, fileutil.ConfigDirName, frameConfigFileName)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return frameProjectConfig{}, nil
+		}
+		return frameProjectConfig{}, fmt.Errorf("failed to read project config: %v", err)
+	}
+
+	var config frameProjectConfig
+	if err := json.Unmarshal(content, &config); err != nil {
+		return frameProjectConfig{}, fmt.Errorf("failed to parse project config %s: %v", path, err)
+	}
+	return config, nil
+}
+
+func (config frameProjectConfig) frameTypeRules() map[string]frameTypeRule {
+	rules := defaultFrameTypeRules()
+	for frameType, rule := range config.FrameTypes {
+		merged := rules[frameType]
+		if rule.AllowStarter != nil {
+			merged.AllowStarter = rule.AllowStarter
+		}
+		if rule.RoutePattern != "" {
+			merged.RoutePattern = rule.RoutePattern
+		}
+		if rule.RootKeyTypes != nil {
+			merged.RootKeyTypes = rule.RootKeyTypes
+		}
+		rules[frameType] = merged
+	}
+	return rules
+}
+
+func validateFrameType(frame FrameDSLModel, rules map[string]frameTypeRule) error {
+	rule, found := rules[frame.Type]
+	if !found {
+		return nil
+	}
+
+	if frame.IsStarter && rule.AllowStarter != nil && !*rule.AllowStarter {
+		return fmt.Errorf("%s frames can not be the starter frame", frame.Type)
+	}
+
+	if rule.RoutePattern != "" {
+		re, err := regexp.Compile(rule.RoutePattern)
+		if err != nil {
+			return fmt.Errorf("invalid route pattern for %s frames: %v", frame.Type, err)
+		}
+		if !re.MatchString(normalizeRoute(frame.Route)) {
+			return fmt.Errorf("route %s does not match the %s route pattern %s", frame.Route, frame.Type, rule.RoutePattern)
+		}
+	}
+
+	if rule.RootKeyTypes != nil {
+		for _, block := range frameRootBlocks(frame.Blocks) {
+			if !containsString(rule.RootKeyTypes, block.KeyType) {
+				return fmt.Errorf("block %s of keyType %s is not allowed at the root of %s frames, allowed keyTypes are %v", block.Key, block.KeyType, frame.Type, rule.RootKeyTypes)
+			}
+		}
+	}
+
+	return nil
+}
+
+func frameRootBlocks(blocks []BlockDSLModel) []BlockDSLModel {
+	var rootBlocks []BlockDSLModel
+	for _, block := range blocks {
+		if block.KeyType == "ROOT" {
+			rootBlocks = append(rootBlocks, block.Blocks...)
+		} else {
+			rootBlocks = append(rootBlocks, block)
+		}
+	}
+	return rootBlocks
+}
+
+func containsString(items []string, value string) bool {
+	for _, item := range items {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package frameModule
+
+func expandFramePropertyValues(frame FrameDSLModel) FrameDSLModel {
+	frame.Blocks = expandBlockPropertyValues(frame.Blocks)
+	return frame
+}
+
+func expandBlockPropertyValues(blocks []BlockDSLModel) []BlockDSLModel {
+	if blocks == nil {
+		return nil
+	}
+
+	expanded := make([]BlockDSLModel, len(blocks))
+	for i, block := range blocks {
+		if block.Properties != nil {
+			properties := make([]BlockPropertyDSLModel, len(block.Properties))
+			for j, property := range block.Properties {
+				properties[j] = property.expandValue()
+			}
+			block.Properties = properties
+		}
+
+		block.Blocks = expandBlockPropertyValues(block.Blocks)
+		expanded[i] = block
+	}
+	return expanded
+}
+
+func fillFrameDefaults(frame FrameDSLModel, blocks map[string]IntegrationSchemaModel, actions map[string]IntegrationSchemaModel) FrameDSLModel {
+	frame.Blocks = fillBlockDefaults(frame.Blocks, blocks, actions)
+	return frame
+}
+
+func fillBlockDefaults(blocks []BlockDSLModel, blockIntegrations map[string]IntegrationSchemaModel, actionIntegrations map[string]IntegrationSchemaModel) []BlockDSLModel {
+	if blocks == nil {
+		return nil
+	}
+
+	filled := make([]BlockDSLModel, len(blocks))
+	for i, block := range blocks {
+		if integration, found := blockIntegrations[block.KeyType]; found {
+			properties := append([]BlockPropertyDSLModel{}, block.Properties...)
+			for _, integrationProperty := range integration.Properties {
+				if integrationProperty.Deprecated || containsBlockProperty(properties, integrationProperty.Key) {
+					continue
+				}
+				properties = append(properties, BlockPropertyDSLModel{
+					Key:          integrationProperty.Key,
+					ValueMobile:  integrationProperty.Value,
+					ValueTablet:  integrationProperty.Value,
+					ValueDesktop: integrationProperty.Value,
+					Type:         integrationProperty.Type,
+				})
+			}
+			block.Properties = properties
+		}
+
+		if block.Actions != nil {
+			actions := make([]ActionDSLModel, len(block.Actions))
+			for j, action := range block.Actions {
+				action.Triggers = fillTriggerDefaults(action.Triggers, actionIntegrations)
+				actions[j] = action
+			}
+			block.Actions = actions
+		}
+
+		block.Blocks = fillBlockDefaults(block.Blocks, blockIntegrations, actionIntegrations)
+		filled[i] = block
+	}
+	return filled
+}
+
+func fillTriggerDefaults(triggers []ActionTriggerDSLModel, actionIntegrations map[string]IntegrationSchemaModel) []ActionTriggerDSLModel {
+	if triggers == nil {
+		return nil
+	}
+
+	filled := make([]ActionTriggerDSLModel, len(triggers))
+	for i, trigger := range triggers {
+		if integration, found := actionIntegrations[trigger.KeyType]; found {
+			properties := append([]TriggerPropertyDSLModel{}, trigger.Properties...)
+			for _, integrationProperty := range integration.Properties {
+				if integrationProperty.Deprecated || containsTriggerProperty(properties, integrationProperty.Key) {
+					continue
+				}
+				properties = append(properties, TriggerPropertyDSLModel{
+					Key:   integrationProperty.Key,
+					Value: integrationProperty.Value,
+					Type:  integrationProperty.Type,
+				})
+			}
+			trigger.Properties = properties
+		}
+
+		trigger.Triggers = fillTriggerDefaults(trigger.Triggers, actionIntegrations)
+		filled[i] = trigger
+	}
+	return filled
+}
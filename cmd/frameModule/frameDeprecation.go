@@ -0,0 +1,124 @@
+package frameModule
+
+import (
+	"fmt"
+	"os"
+)
+
+func checkFrameDeprecations(frame FrameDSLModel, options frameGenerateOptions) error {
+	if options.Blocks == nil && options.Actions == nil {
+		return nil
+	}
+
+	warnings := options.Warnings
+	if warnings == nil {
+		warnings = os.Stderr
+	}
+
+	issues := findFrameDeprecations(frame, options.Blocks, options.Actions)
+	for _, issue := range issues {
+		fmt.Fprintf(warnings, "%s: %s: %s\n", issue.Severity, issue.Path, issue.Message)
+	}
+
+	if options.StrictDeprecations && len(issues) > 0 {
+		return fmt.Errorf("%v deprecated usages found", len(issues))
+	}
+	return nil
+}
+
+func findFrameDeprecations(frame FrameDSLModel, blocks map[string]IntegrationSchemaModel, actions map[string]IntegrationSchemaModel) []frameLintIssue {
+	var issues []frameLintIssue
+	findBlockDeprecations(frame.Blocks, "", blocks, actions, &issues)
+	return issues
+}
+
+func findBlockDeprecations(blocks []BlockDSLModel, parentPath string, blockIntegrations map[string]IntegrationSchemaModel, actionIntegrations map[string]IntegrationSchemaModel, issues *[]frameLintIssue) {
+	for index, block := range blocks {
+		path := fmt.Sprintf("%sblocks[%d](%s)", parentPath, index, block.Key)
+
+		integration, found := blockIntegrations[block.KeyType]
+		if found {
+			if integration.Deprecated {
+				addDeprecationIssue(issues, path, "block "+block.KeyType, integration.DeprecatedReason)
+			}
+
+			for propertyIndex, property := range block.Properties {
+				for _, item := range integration.Properties {
+					if item.Key == property.Key && item.Deprecated {
+						addDeprecationIssue(issues, fmt.Sprintf("%s.properties[%d](%s)", path, propertyIndex, property.Key), "property "+property.Key+" of "+block.KeyType, item.DeprecatedReason)
+					}
+				}
+			}
+
+			for dataIndex, dataItem := range block.Data {
+				for _, item := range integration.Data {
+					if item.Key == dataItem.Key && item.Deprecated {
+						addDeprecationIssue(issues, fmt.Sprintf("%s.data[%d](%s)", path, dataIndex, dataItem.Key), "data "+dataItem.Key+" of "+block.KeyType, item.DeprecatedReason)
+					}
+				}
+			}
+
+			for slotIndex, slot := range block.Slots {
+				for _, item := range integration.Slots {
+					if item.Slot == slot.Slot && item.Deprecated {
+						addDeprecationIssue(issues, fmt.Sprintf("%s.slots[%d](%s)", path, slotIndex, slot.Slot), "slot "+slot.Slot+" of "+block.KeyType, item.DeprecatedReason)
+					}
+				}
+			}
+
+			for actionIndex, action := range block.Actions {
+				for _, item := range integration.Events {
+					if item.Event == action.Event && item.Deprecated {
+						addDeprecationIssue(issues, fmt.Sprintf("%s.actions[%d](%s)", path, actionIndex, action.Event), "event "+action.Event+" of "+block.KeyType, item.DeprecatedReason)
+					}
+				}
+			}
+		}
+
+		for actionIndex, action := range block.Actions {
+			actionPath := fmt.Sprintf("%s.actions[%d](%s).", path, actionIndex, action.Event)
+			findTriggerDeprecations(action.Triggers, actionPath, actionIntegrations, issues)
+		}
+
+		findBlockDeprecations(block.Blocks, path+".", blockIntegrations, actionIntegrations, issues)
+	}
+}
+
+func findTriggerDeprecations(triggers []ActionTriggerDSLModel, parentPath string, actionIntegrations map[string]IntegrationSchemaModel, issues *[]frameLintIssue) {
+	for index, trigger := range triggers {
+		path := fmt.Sprintf("%striggers[%d](%s)", parentPath, index, trigger.Name)
+
+		integration, found := actionIntegrations[trigger.KeyType]
+		if found {
+			if integration.Deprecated {
+				addDeprecationIssue(issues, path, "action "+trigger.KeyType, integration.DeprecatedReason)
+			}
+
+			for propertyIndex, property := range trigger.Properties {
+				for _, item := range integration.Properties {
+					if item.Key == property.Key && item.Deprecated {
+						addDeprecationIssue(issues, fmt.Sprintf("%s.properties[%d](%s)", path, propertyIndex, property.Key), "property "+property.Key+" of "+trigger.KeyType, item.DeprecatedReason)
+					}
+				}
+			}
+
+			for dataIndex, dataItem := range trigger.Data {
+				for _, item := range integration.Data {
+					if item.Key == dataItem.Key && item.Deprecated {
+						addDeprecationIssue(issues, fmt.Sprintf("%s.data[%d](%s)", path, dataIndex, dataItem.Key), "data "+dataItem.Key+" of "+trigger.KeyType, item.DeprecatedReason)
+					}
+				}
+			}
+		}
+
+		findTriggerDeprecations(trigger.Triggers, path+".", actionIntegrations, issues)
+	}
+}
+
+func addDeprecationIssue(issues *[]frameLintIssue, path string, subject string, reason string) {
+	message := subject + " is deprecated"
+	if reason != "" {
+		message += ": " + reason
+	}
+	*issues = append(*issues, frameLintIssue{Severity: lintSeverityWarning, Path: path, Message: message})
+}
@@ -0,0 +1,278 @@
+package frameModule
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+const (
+	frameDiffAdded   = "added"
+	frameDiffRemoved = "removed"
+	frameDiffChanged = "changed"
+)
+
+type frameDiffEntry struct {
+	Change  string
+	Kind    string
+	Name    string
+	Details []string
+}
+
+func diffFrames(remote FrameDSLModel, local FrameDSLModel, compareVersions bool) []frameDiffEntry {
+	var entries []frameDiffEntry
+
+	var frameChanges []string
+	if remote.Name != local.Name {
+		frameChanges = append(frameChanges, "name")
+	}
+	if normalizeRoute(remote.Route) != normalizeRoute(local.Route) {
+		frameChanges = append(frameChanges, "route")
+	}
+	if remote.Type != local.Type {
+		frameChanges = append(frameChanges, "type")
+	}
+	if remote.IsStarter != local.IsStarter {
+		frameChanges = append(frameChanges, "isStarter")
+	}
+	if len(frameChanges) > 0 {
+		entries = append(entries, frameDiffEntry{Change: frameDiffChanged, Kind: "frame", Name: local.Route, Details: frameChanges})
+	}
+
+	entries = append(entries, diffKeyedElements("variable",
+		indexKeyedElements(remote.Variables, func(variable VariableDSLModel) string { return variable.Key }),
+		indexKeyedElements(local.Variables, func(variable VariableDSLModel) string { return variable.Key }),
+		keyedOrder(remote.Variables, local.Variables, func(variable VariableDSLModel) string { return variable.Key }),
+		func(remoteVariable VariableDSLModel, localVariable VariableDSLModel) []string {
+			var changes []string
+			if remoteVariable.Value != localVariable.Value {
+				changes = append(changes, "value")
+			}
+			if remoteVariable.Type != localVariable.Type {
+				changes = append(changes, "type")
+			}
+			return changes
+		},
+	)...)
+
+	remoteBlocks := make(map[string]flatBlockDSL)
+	localBlocks := make(map[string]flatBlockDSL)
+	var remoteOrder, localOrder []string
+	flattenBlocksDSL(remote.Blocks, "", remoteBlocks, &remoteOrder)
+	flattenBlocksDSL(local.Blocks, "", localBlocks, &localOrder)
+	blockOrder := keyedOrder(remoteOrder, localOrder, func(identity string) string { return identity })
+
+	entries = append(entries, diffKeyedElements("block", remoteBlocks, localBlocks, blockOrder,
+		func(remoteBlock flatBlockDSL, localBlock flatBlockDSL) []string {
+			return diffFlatBlocks(remoteBlock, localBlock, compareVersions)
+		},
+	)...)
+
+	remoteActions := make(map[string]ActionDSLModel)
+	localActions := make(map[string]ActionDSLModel)
+	var remoteActionOrder, localActionOrder []string
+	for _, identity := range remoteOrder {
+		for _, action := range remoteBlocks[identity].Block.Actions {
+			remoteActions[identity+"."+action.Event] = action
+			remoteActionOrder = append(remoteActionOrder, identity+"."+action.Event)
+		}
+	}
+	for _, identity := range localOrder {
+		for _, action := range localBlocks[identity].Block.Actions {
+			localActions[identity+"."+action.Event] = action
+			localActionOrder = append(localActionOrder, identity+"."+action.Event)
+		}
+	}
+	actionOrder := keyedOrder(remoteActionOrder, localActionOrder, func(key string) string { return key })
+
+	entries = append(entries, diffKeyedElements("action", remoteActions, localActions, actionOrder,
+		func(remoteAction ActionDSLModel, localAction ActionDSLModel) []string {
+			if sameJSON(normalizeTriggersForDiff(remoteAction.Triggers, compareVersions), normalizeTriggersForDiff(localAction.Triggers, compareVersions)) {
+				return nil
+			}
+			return []string{"triggers"}
+		},
+	)...)
+
+	return entries
+}
+
+func diffKeyedElements[T any](kind string, remote map[string]T, local map[string]T, order []string, compare func(T, T) []string) []frameDiffEntry {
+	var entries []frameDiffEntry
+	for _, key := range order {
+		remoteItem, inRemote := remote[key]
+		localItem, inLocal := local[key]
+
+		switch {
+		case inRemote && inLocal:
+			if changes := compare(remoteItem, localItem); len(changes) > 0 {
+				entries = append(entries, frameDiffEntry{Change: frameDiffChanged, Kind: kind, Name: key, Details: changes})
+			}
+		case inLocal:
+			entries = append(entries, frameDiffEntry{Change: frameDiffAdded, Kind: kind, Name: key})
+		case inRemote:
+			entries = append(entries, frameDiffEntry{Change: frameDiffRemoved, Kind: kind, Name: key})
+		}
+	}
+	return entries
+}
+
+func keyedOrder[T any](remote []T, local []T, keyOf func(T) string) []string {
+	var order []string
+	seen := make(map[string]bool)
+	for _, items := range [][]T{local, remote} {
+		for _, item := range items {
+			if key := keyOf(item); !seen[key] {
+				seen[key] = true
+				order = append(order, key)
+			}
+		}
+	}
+	return order
+}
+
+func diffFlatBlocks(remote flatBlockDSL, local flatBlockDSL, compareVersions bool) []string {
+	var changes []string
+	if remote.Block.KeyType != local.Block.KeyType {
+		changes = append(changes, "keyType")
+	}
+	if compareVersions && remote.Block.IntegrationVersion != local.Block.IntegrationVersion {
+		changes = append(changes, "integrationVersion")
+	}
+	if remote.Block.VisibilityKey != local.Block.VisibilityKey {
+		changes = append(changes, "visibilityKey")
+	}
+	if normalizeSlotForDiff(remote.Block.Slot) != normalizeSlotForDiff(local.Block.Slot) {
+		changes = append(changes, "slot")
+	}
+	if remote.ParentKey != local.ParentKey {
+		changes = append(changes, "parent")
+	} else if remote.Position != local.Position {
+		changes = append(changes, "position")
+	}
+
+	remoteProperties := indexKeyedElements(remote.Block.Properties, func(property BlockPropertyDSLModel) string { return property.Key })
+	localProperties := indexKeyedElements(local.Block.Properties, func(property BlockPropertyDSLModel) string { return property.Key })
+	for _, key := range keyedOrder(remote.Block.Properties, local.Block.Properties, func(property BlockPropertyDSLModel) string { return property.Key }) {
+		if !reflect.DeepEqual(remoteProperties[key], localProperties[key]) {
+			changes = append(changes, "property "+key)
+		}
+	}
+
+	remoteData := indexKeyedElements(remote.Block.Data, func(data BlockDataDSLModel) string { return data.Key })
+	localData := indexKeyedElements(local.Block.Data, func(data BlockDataDSLModel) string { return data.Key })
+	for _, key := range keyedOrder(remote.Block.Data, local.Block.Data, func(data BlockDataDSLModel) string { return data.Key }) {
+		if !reflect.DeepEqual(remoteData[key], localData[key]) {
+			changes = append(changes, "data "+key)
+		}
+	}
+
+	if !sameJSON(formatSlotsForDiff(remote.Block.Slots), formatSlotsForDiff(local.Block.Slots)) {
+		changes = append(changes, "slots")
+	}
+	return changes
+}
+
+func normalizeSlotForDiff(slot string) string {
+	if slot == "null" {
+		return ""
+	}
+	return slot
+}
+
+func formatSlotsForDiff(slots []BlockSlotDSLModel) []BlockSlotDSLModel {
+	if slots == nil {
+		return []BlockSlotDSLModel{}
+	}
+	return slots
+}
+
+func normalizeTriggersForDiff(triggers []ActionTriggerDSLModel, compareVersions bool) []ActionTriggerDSLModel {
+	normalized := formatTriggersDSL(triggers)
+	for i := range normalized {
+		if !compareVersions {
+			normalized[i].IntegrationVersion = 0
+		}
+		normalized[i].Triggers = normalizeTriggersForDiff(normalized[i].Triggers, compareVersions)
+	}
+	return normalized
+}
+
+func sameJSON(a interface{}, b interface{}) bool {
+	aJson, aErr := json.Marshal(a)
+	bJson, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aJson) == string(bJson)
+}
+
+func renderFrameDiff(entries []frameDiffEntry) string {
+	var builder strings.Builder
+
+	counts := make(map[string]map[string]int)
+	for _, entry := range entries {
+		if counts[entry.Kind] == nil {
+			counts[entry.Kind] = make(map[string]int)
+		}
+		counts[entry.Kind][entry.Change]++
+	}
+	for _, kind := range []string{"variable", "block", "action"} {
+		builder.WriteString(fmt.Sprintf("%ss: %v added, %v removed, %v changed\n", kind, counts[kind][frameDiffAdded], counts[kind][frameDiffRemoved], counts[kind][frameDiffChanged]))
+	}
+
+	for _, entry := range entries {
+		marker := "~"
+		switch entry.Change {
+		case frameDiffAdded:
+			marker = "+"
+		case frameDiffRemoved:
+			marker = "-"
+		}
+		line := fmt.Sprintf("  %s %s %s", marker, entry.Kind, entry.Name)
+		if len(entry.Details) > 0 {
+			line += ": " + strings.Join(entry.Details, ", ")
+		}
+		builder.WriteString(line + "\n")
+	}
+	return builder.String()
+}
+
+func dryRunPushFrame(output FrameProductionDataWrapper, schema string, outputFile string, regionUrl string, accessToken string, apiKey string) error {
+	input, err := buildSyncFrameInput(output)
+	if err != nil {
+		return err
+	}
+
+	inputJson, err := json.MarshalIndent(input, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if outputFile == "" {
+		fmt.Println(string(inputJson))
+	} else {
+		if err := os.WriteFile(outputFile, inputJson, 0644); err != nil {
+			return fmt.Errorf("failed to write sync input: %v", err)
+		}
+		fmt.Printf("Sync input saved into %s \n", outputFile)
+	}
+
+	frame := output.Data.FrameProduction
+	remote, hasVersions, err := getFrameWithVersions(regionUrl, accessToken, apiKey, frame.Route)
+	if err != nil {
+		return err
+	}
+
+	if remote.Route == "" {
+		fmt.Printf("Frame %s does not exist on the remote, it will be created \n", frame.Route)
+		return nil
+	}
+
+	if !hasVersions {
+		fmt.Fprintf(os.Stderr, "warning: the remote does not return integration versions, they are not compared\n")
+	}
+	fmt.Printf("Changes against the remote frame %s \n", frame.Route)
+	fmt.Print(renderFrameDiff(diffFrames(mapFrameModelToDSL(remote, schema), mapFrameModelToDSL(frame, schema), hasVersions)))
+	fmt.Printf("Dry run, nothing was pushed \n")
+	return nil
+}
@@ -1,6 +1,9 @@
 package frameModule
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"github.com/google/uuid"
 )
 
@@ -25,6 +28,9 @@ type VariableDSLModel struct {
 }
 
 type BlockDSLModel struct {
+	Include            string                  `json:"$include,omitempty"`
+	Prefix             string                  `json:"prefix,omitempty"`
+	Params             map[string]string       `json:"params,omitempty"`
 	KeyType            string                  `json:"keyType"`
 	Key                string                  `json:"key"`
 	VisibilityKey      string                  `json:"visibilityKey"`
@@ -37,14 +43,85 @@ type BlockDSLModel struct {
 	Actions            []ActionDSLModel        `json:"actions"`
 }
 
+type blockIncludeDSLModel struct {
+	Include string            `json:"$include"`
+	Prefix  string            `json:"prefix,omitempty"`
+	Slot    string            `json:"slot,omitempty"`
+	Params  map[string]string `json:"params,omitempty"`
+}
+
+func (block BlockDSLModel) MarshalJSON() ([]byte, error) {
+	type blockDSL BlockDSLModel
+
+	var value interface{} = blockDSL(block)
+	if block.Include != "" {
+		value = blockIncludeDSLModel{
+			Include: block.Include,
+			Prefix:  block.Prefix,
+			Slot:    block.Slot,
+			Params:  block.Params,
+		}
+	}
+
+	return marshalUnescapedJSON(value)
+}
+
+func marshalUnescapedJSON(value interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buffer.Bytes(), "\n"), nil
+}
+
 type BlockPropertyDSLModel struct {
 	Key          string `json:"key"`
+	Value        string `json:"value,omitempty"`
 	ValueMobile  string `json:"valueMobile"`
 	ValueTablet  string `json:"valueTablet"`
 	ValueDesktop string `json:"valueDesktop"`
 	Type         string `json:"type"`
 }
 
+type blockPropertyShorthandDSLModel struct {
+	Key          string `json:"key"`
+	Value        string `json:"value"`
+	ValueMobile  string `json:"valueMobile,omitempty"`
+	ValueTablet  string `json:"valueTablet,omitempty"`
+	ValueDesktop string `json:"valueDesktop,omitempty"`
+	Type         string `json:"type"`
+}
+
+func (property BlockPropertyDSLModel) MarshalJSON() ([]byte, error) {
+	type blockPropertyDSL BlockPropertyDSLModel
+
+	var value interface{} = blockPropertyDSL(property)
+	if property.Value != "" {
+		value = blockPropertyShorthandDSLModel(property)
+	}
+	return marshalUnescapedJSON(value)
+}
+
+func (property BlockPropertyDSLModel) expandValue() BlockPropertyDSLModel {
+	if property.Value == "" {
+		return property
+	}
+
+	if property.ValueMobile == "" {
+		property.ValueMobile = property.Value
+	}
+	if property.ValueTablet == "" {
+		property.ValueTablet = property.Value
+	}
+	if property.ValueDesktop == "" {
+		property.ValueDesktop = property.Value
+	}
+	property.Value = ""
+	return property
+}
+
 type BlockDataDSLModel struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
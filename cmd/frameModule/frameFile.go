@@ -0,0 +1,216 @@
+package frameModule
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nativeblocks/cli/library/fileutil"
+)
+
+var routeSlugBracesPattern = regexp.MustCompile(`[{}]`)
+
+var routeSlugInvalidPattern = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+func frameFileName(route string) string {
+	return routeSlug(route) + ".json"
+}
+
+func frameFileNames(routes []string) map[string]string {
+	sorted := append([]string{}, routes...)
+	sort.Strings(sorted)
+
+	fileNames := make(map[string]string)
+	used := make(map[string]bool)
+	for _, route := range sorted {
+		slug := routeSlug(route)
+		fileName := slug + ".json"
+		for i := 2; used[fileName]; i++ {
+			fileName = fmt.Sprintf("%s-%v.json", slug, i)
+		}
+		used[fileName] = true
+		fileNames[route] = fileName
+	}
+	return fileNames
+}
+
+func routeSlug(route string) string {
+	name := strings.Trim(normalizeRoute(route), "/")
+	name = routeSlugBracesPattern.ReplaceAllString(name, "")
+	name = routeSlugInvalidPattern.ReplaceAllString(name, "-")
+	name = strings.Trim(name, "-")
+	if name == "" {
+		name = "index"
+	}
+	return name
+}
+
+func findProjectDir(startDir string) string {
+	dir, err := filepath.Abs(startDir)
+	if err != nil {
+		return ""
+	}
+	homeDir, _ := os.UserHomeDir()
+
+	for {
+		if dir == homeDir {
+			return ""
+		}
+
+		info, err := os.Stat(filepath.Join(dir, fileutil.ConfigDirName))
+		if err == nil && info.IsDir() {
+			return dir
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+func loadFrameDSL(path string) (FrameDSLModel, error) {
+	baseDir := fileutil.GetFileDir(path)
+	fileName := fileutil.GetFileName(path)
+
+	fm, err := fileutil.NewFileManager(&baseDir)
+	if err != nil {
+		return FrameDSLModel{}, err
+	}
+
+	if !fm.FileExists(fileName) {
+		return FrameDSLModel{}, fmt.Errorf("could not find the file under: %v", path)
+	}
+
+	if isCompactFrameFile(path) {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return FrameDSLModel{}, fmt.Errorf("failed to read file: %v", err)
+		}
+		frame, _, err := parseCompactFrame(content)
+		if err != nil {
+			return FrameDSLModel{}, fmt.Errorf("failed to parse %s: %v", path, err)
+		}
+		return frame, nil
+	}
+
+	var frame FrameDSLModel
+	if err := fm.LoadFromFile(fileName, &frame); err != nil {
+		return FrameDSLModel{}, err
+	}
+	return frame, nil
+}
+
+func marshalFrameFile(path string, frame FrameDSLModel) ([]byte, error) {
+	if isCompactFrameFile(path) {
+		return printCompactFrame(frame), nil
+	}
+	return marshalFrameDSL(frame)
+}
+
+func saveFrameFile(path string, frame FrameDSLModel) error {
+	content, err := marshalFrameFile(path, frame)
+	if err != nil {
+		return err
+	}
+
+	baseDir := fileutil.GetFileDir(path)
+	fm, err := fileutil.NewFileManager(&baseDir)
+	if err != nil {
+		return err
+	}
+	return fm.SaveByteToFile(fileutil.GetFileName(path), content)
+}
+
+func loadFrameModel(path string, options frameGenerateOptions) (FrameModel, error) {
+	frameDSL, err := loadFrameDSL(path)
+	if err != nil {
+		return FrameModel{}, err
+	}
+
+	options.BaseDir = fileutil.GetFileDir(path)
+	output, err := generateFrame(frameDSL, options)
+	if err != nil {
+		return FrameModel{}, err
+	}
+	if output.Data.FrameProduction.Id == "" {
+		return FrameModel{}, fmt.Errorf("could not generate frame %v, please check your input", path)
+	}
+	return output.Data.FrameProduction, nil
+}
+
+func isFrameFile(path string) bool {
+	if filepath.Ext(path) != ".json" && !isCompactFrameFile(path) {
+		return false
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+
+	if isCompactFrameFile(path) {
+		_, _, err := parseCompactFrame(content)
+		return err == nil
+	}
+
+	var frame map[string]json.RawMessage
+	if err := json.Unmarshal(content, &frame); err != nil {
+		return false
+	}
+
+	_, hasRoute := frame["route"]
+	_, hasBlocks := frame["blocks"]
+	return hasRoute && hasBlocks
+}
+
+func findFrameFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not find the path: %v", path)
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if filePath != path && strings.HasPrefix(entry.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if isFrameFile(filePath) {
+			files = append(files, filePath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk path %v: %v", path, err)
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+func fileModTime(path string) (time.Time, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.ModTime(), nil
+}
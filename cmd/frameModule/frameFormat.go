@@ -0,0 +1,101 @@
+package frameModule
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/nativeblocks/cli/library/fileutil"
+)
+
+func formatFrameDSL(frame FrameDSLModel) FrameDSLModel {
+	variables := make([]VariableDSLModel, len(frame.Variables))
+	copy(variables, frame.Variables)
+	sort.SliceStable(variables, func(i, j int) bool {
+		return variables[i].Key < variables[j].Key
+	})
+	frame.Variables = variables
+
+	frame.Blocks = formatBlocksDSL(frame.Blocks)
+	return frame
+}
+
+func formatBlocksDSL(blocks []BlockDSLModel) []BlockDSLModel {
+	formatted := make([]BlockDSLModel, len(blocks))
+	for i, block := range blocks {
+		if block.Include != "" {
+			formatted[i] = block
+			continue
+		}
+
+		if block.Slot == "" {
+			block.Slot = "null"
+		}
+		if block.Data == nil {
+			block.Data = []BlockDataDSLModel{}
+		}
+		if block.Properties == nil {
+			block.Properties = []BlockPropertyDSLModel{}
+		}
+		if block.Slots == nil {
+			block.Slots = []BlockSlotDSLModel{}
+		}
+
+		actions := make([]ActionDSLModel, len(block.Actions))
+		for j, action := range block.Actions {
+			action.Triggers = formatTriggersDSL(action.Triggers)
+			actions[j] = action
+		}
+		block.Actions = actions
+
+		block.Blocks = formatBlocksDSL(block.Blocks)
+		formatted[i] = block
+	}
+	return formatted
+}
+
+func formatTriggersDSL(triggers []ActionTriggerDSLModel) []ActionTriggerDSLModel {
+	formatted := make([]ActionTriggerDSLModel, len(triggers))
+	for i, trigger := range triggers {
+		if trigger.Properties == nil {
+			trigger.Properties = []TriggerPropertyDSLModel{}
+		}
+		if trigger.Data == nil {
+			trigger.Data = []TriggerDataDSLModel{}
+		}
+		trigger.Triggers = formatTriggersDSL(trigger.Triggers)
+		formatted[i] = trigger
+	}
+	return formatted
+}
+
+func marshalFrameDSL(frame FrameDSLModel) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(formatFrameDSL(frame)); err != nil {
+		return nil, fmt.Errorf("failed to marshal frame: %v", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+func marshalBlockDSL(block BlockDSLModel) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(formatBlocksDSL([]BlockDSLModel{block})[0]); err != nil {
+		return nil, fmt.Errorf("failed to marshal block: %v", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+func saveFrameDSL(fm fileutil.FileManager, fileName string, frame FrameDSLModel) error {
+	frameBytes, err := marshalFrameDSL(frame)
+	if err != nil {
+		return err
+	}
+	return fm.SaveByteToFile(fileName, frameBytes)
+}
@@ -0,0 +1,175 @@
+package frameModule
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	frameGraphBlockNode   = "block"
+	frameGraphEventNode   = "event"
+	frameGraphTriggerNode = "trigger"
+)
+
+type frameGraphNode struct {
+	Id    string
+	Kind  string
+	Lines []string
+}
+
+type frameGraphEdge struct {
+	From   string
+	To     string
+	Label  string
+	Dashed bool
+}
+
+type frameGraph struct {
+	Title string
+	Nodes []frameGraphNode
+	Edges []frameGraphEdge
+}
+
+func buildFrameGraph(frame FrameModel) frameGraph {
+	graph := frameGraph{Title: frame.Route}
+
+	blockNodes := make(map[string]string)
+	blockKeyNodes := make(map[string]string)
+	for index, block := range frame.Blocks {
+		nodeId := fmt.Sprintf("b%d", index)
+		blockNodes[block.Id] = nodeId
+		blockKeyNodes[block.Key] = nodeId
+		graph.Nodes = append(graph.Nodes, frameGraphNode{Id: nodeId, Kind: frameGraphBlockNode, Lines: []string{block.KeyType, block.Key}})
+	}
+
+	for _, block := range frame.Blocks {
+		parentNode, found := blockNodes[block.ParentId]
+		if !found {
+			continue
+		}
+		label := block.Slot
+		if label == "null" {
+			label = ""
+		}
+		graph.Edges = append(graph.Edges, frameGraphEdge{From: parentNode, To: blockNodes[block.Id], Label: label})
+	}
+
+	triggerIndex := 0
+	for actionIndex, action := range frame.Actions {
+		eventNode := fmt.Sprintf("e%d", actionIndex)
+		graph.Nodes = append(graph.Nodes, frameGraphNode{Id: eventNode, Kind: frameGraphEventNode, Lines: []string{action.Event}})
+		if blockNode, found := blockKeyNodes[action.Key]; found {
+			graph.Edges = append(graph.Edges, frameGraphEdge{From: blockNode, To: eventNode, Dashed: true})
+		}
+
+		triggerNodes := make(map[string]string)
+		for _, trigger := range action.Triggers {
+			triggerNode := fmt.Sprintf("t%d", triggerIndex)
+			triggerIndex++
+			triggerNodes[trigger.Id] = triggerNode
+			graph.Nodes = append(graph.Nodes, frameGraphNode{Id: triggerNode, Kind: frameGraphTriggerNode, Lines: []string{trigger.KeyType, trigger.Name}})
+		}
+
+		for _, trigger := range action.Triggers {
+			parentNode := eventNode
+			if trigger.ParentId != "" {
+				parentNode = triggerNodes[trigger.ParentId]
+			}
+			graph.Edges = append(graph.Edges, frameGraphEdge{From: parentNode, To: triggerNodes[trigger.Id], Label: trigger.Then})
+		}
+	}
+
+	return graph
+}
+
+func renderFrameGraph(graph frameGraph, format string) (string, error) {
+	switch format {
+	case "mermaid":
+		return renderMermaidGraph(graph), nil
+	case "dot":
+		return renderDotGraph(graph), nil
+	default:
+		return "", fmt.Errorf("unsupported graph format %v, it must be mermaid or dot", format)
+	}
+}
+
+func renderMermaidGraph(graph frameGraph) string {
+	var builder strings.Builder
+	builder.WriteString("---\n")
+	builder.WriteString("title: " + graph.Title + "\n")
+	builder.WriteString("---\n")
+	builder.WriteString("flowchart TD\n")
+
+	for _, node := range graph.Nodes {
+		label := escapeMermaidLabel(strings.Join(node.Lines, "\n"))
+		label = strings.ReplaceAll(label, "\n", "<br/>")
+		switch node.Kind {
+		case frameGraphEventNode:
+			builder.WriteString(fmt.Sprintf("    %s([\"%s\"])\n", node.Id, label))
+		case frameGraphTriggerNode:
+			builder.WriteString(fmt.Sprintf("    %s{{\"%s\"}}\n", node.Id, label))
+		default:
+			builder.WriteString(fmt.Sprintf("    %s[\"%s\"]\n", node.Id, label))
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		arrow := "-->"
+		if edge.Dashed {
+			arrow = "-.->"
+		}
+		if edge.Label == "" {
+			builder.WriteString(fmt.Sprintf("    %s %s %s\n", edge.From, arrow, edge.To))
+		} else {
+			builder.WriteString(fmt.Sprintf("    %s %s|\"%s\"| %s\n", edge.From, arrow, escapeMermaidLabel(edge.Label), edge.To))
+		}
+	}
+
+	return builder.String()
+}
+
+func renderDotGraph(graph frameGraph) string {
+	var builder strings.Builder
+	builder.WriteString("digraph frame {\n")
+	builder.WriteString(fmt.Sprintf("    label=\"%s\";\n", escapeDotLabel(graph.Title)))
+	builder.WriteString("    rankdir=TB;\n")
+
+	for _, node := range graph.Nodes {
+		shape := "box"
+		switch node.Kind {
+		case frameGraphEventNode:
+			shape = "ellipse"
+		case frameGraphTriggerNode:
+			shape = "hexagon"
+		}
+		builder.WriteString(fmt.Sprintf("    %s [label=\"%s\", shape=%s];\n", node.Id, escapeDotLabel(strings.Join(node.Lines, "\n")), shape))
+	}
+
+	for _, edge := range graph.Edges {
+		var attributes []string
+		if edge.Label != "" {
+			attributes = append(attributes, fmt.Sprintf("label=\"%s\"", escapeDotLabel(edge.Label)))
+		}
+		if edge.Dashed {
+			attributes = append(attributes, "style=dashed")
+		}
+		if len(attributes) == 0 {
+			builder.WriteString(fmt.Sprintf("    %s -> %s;\n", edge.From, edge.To))
+		} else {
+			builder.WriteString(fmt.Sprintf("    %s -> %s [%s];\n", edge.From, edge.To, strings.Join(attributes, ", ")))
+		}
+	}
+
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func escapeMermaidLabel(label string) string {
+	return strings.ReplaceAll(label, "\"", "#quot;")
+}
+
+func escapeDotLabel(label string) string {
+	label = strings.ReplaceAll(label, "\\", "\\\\")
+	label = strings.ReplaceAll(label, "\"", "\\\"")
+	return strings.ReplaceAll(label, "\n", "\\n")
+}
@@ -0,0 +1,121 @@
+package frameModule
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nativeblocks/cli/library/fileutil"
+)
+
+const (
+	frameHistoryDirName = "history"
+
+	frameSnapshotPrevious = "previous"
+	frameSnapshotPushed   = "pushed"
+
+	frameSnapshotTimeLayout       = "20060102-150405.000000000"
+	frameSnapshotLegacyTimeLayout = "20060102-150405"
+)
+
+type frameSnapshot struct {
+	Id   string
+	Kind string
+	Time time.Time
+}
+
+func frameHistoryDir(baseFm fileutil.FileManager, projectId string, route string) string {
+	return filepath.Join(baseFm.BaseDir, frameHistoryDirName, projectId, routeSlug(route))
+}
+
+func saveFrameSnapshot(historyDir string, kind string, timestamp time.Time, frame FrameDSLModel) (string, error) {
+	fm, err := fileutil.NewFileManager(&historyDir)
+	if err != nil {
+		return "", err
+	}
+
+	id := timestamp.Format(frameSnapshotTimeLayout) + "-" + kind
+	if err := saveFrameDSL(*fm, id+".json", frame); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func listFrameSnapshots(historyDir string) ([]frameSnapshot, error) {
+	entries, err := os.ReadDir(historyDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read history: %v", err)
+	}
+
+	var snapshots []frameSnapshot
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		id := strings.TrimSuffix(entry.Name(), ".json")
+		separator := strings.LastIndex(id, "-")
+		if separator < 0 {
+			continue
+		}
+
+		timestamp, err := time.ParseInLocation(frameSnapshotTimeLayout, id[:separator], time.Local)
+		if err != nil {
+			timestamp, err = time.ParseInLocation(frameSnapshotLegacyTimeLayout, id[:separator], time.Local)
+			if err != nil {
+				continue
+			}
+		}
+		snapshots = append(snapshots, frameSnapshot{Id: id, Kind: id[separator+1:], Time: timestamp})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Id > snapshots[j].Id
+	})
+	return snapshots, nil
+}
+
+func loadFrameSnapshot(historyDir string, id string) (FrameDSLModel, error) {
+	path := filepath.Join(historyDir, strings.TrimSuffix(id, ".json")+".json")
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return FrameDSLModel{}, fmt.Errorf("could not find snapshot %v", id)
+	}
+	return loadFrameDSL(path)
+}
+
+func pushFrameWithHistory(output FrameProductionDataWrapper, schema string, historyDir string, regionUrl string, accessToken string, apiKey string) error {
+	frame := output.Data.FrameProduction
+	if frame.Id == "" {
+		return pushFrame(output, regionUrl, accessToken, apiKey)
+	}
+
+	timestamp := time.Now()
+	previous, err := getFrame(regionUrl, accessToken, apiKey, frame.Route)
+	if err != nil {
+		return fmt.Errorf("failed to store the previous remote version, nothing was pushed: %v", err)
+	}
+	if previous.Route != "" {
+		id, err := saveFrameSnapshot(historyDir, frameSnapshotPrevious, timestamp, mapFrameModelToDSL(previous, schema))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Previous remote version stored as %s \n", id)
+	}
+
+	if err := pushFrame(output, regionUrl, accessToken, apiKey); err != nil {
+		return err
+	}
+
+	id, err := saveFrameSnapshot(historyDir, frameSnapshotPushed, timestamp, mapFrameModelToDSL(frame, schema))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Pushed version stored as %s \n", id)
+	return nil
+}
@@ -0,0 +1,195 @@
+package frameModule
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var includeParamPattern = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_.-]+)\s*\}\}`)
+
+func expandFrameIncludes(frame FrameDSLModel, baseDir string) (FrameDSLModel, error) {
+	blocks, err := expandBlockIncludes(frame.Blocks, baseDir, []string{})
+	if err != nil {
+		return FrameDSLModel{}, err
+	}
+	frame.Blocks = blocks
+	return frame, nil
+}
+
+func expandBlockIncludes(blocks []BlockDSLModel, baseDir string, stack []string) ([]BlockDSLModel, error) {
+	if blocks == nil {
+		return nil, nil
+	}
+
+	expanded := make([]BlockDSLModel, 0, len(blocks))
+	for _, block := range blocks {
+		if block.Include == "" {
+			children, err := expandBlockIncludes(block.Blocks, baseDir, stack)
+			if err != nil {
+				return nil, err
+			}
+			block.Blocks = children
+			expanded = append(expanded, block)
+			continue
+		}
+
+		fragment, err := loadBlockFragment(block, baseDir, stack)
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, fragment)
+	}
+	return expanded, nil
+}
+
+func loadBlockFragment(include BlockDSLModel, baseDir string, stack []string) (BlockDSLModel, error) {
+	fragmentPath, err := resolveIncludePath(include.Include, baseDir)
+	if err != nil {
+		return BlockDSLModel{}, err
+	}
+
+	for _, path := range stack {
+		if path == fragmentPath {
+			return BlockDSLModel{}, fmt.Errorf("include cycle found: %s -> %s", strings.Join(stack, " -> "), fragmentPath)
+		}
+	}
+
+	content, err := os.ReadFile(fragmentPath)
+	if err != nil {
+		return BlockDSLModel{}, fmt.Errorf("could not read the include %s: %v", include.Include, err)
+	}
+
+	substituted, missingParams := substituteIncludeParams(string(content), include.Params)
+	if len(missingParams) > 0 {
+		return BlockDSLModel{}, fmt.Errorf("missing params for the include %s: %s", include.Include, strings.Join(missingParams, ","))
+	}
+
+	fragment, err := parseBlockFragment(fragmentPath, []byte(substituted))
+	if err != nil {
+		return BlockDSLModel{}, fmt.Errorf("failed to parse the include %s: %v", include.Include, err)
+	}
+
+	fragmentStack := append(append([]string{}, stack...), fragmentPath)
+	if fragment.Include != "" {
+		fragment, err = loadBlockFragment(fragment, filepath.Dir(fragmentPath), fragmentStack)
+	} else {
+		fragment.Blocks, err = expandBlockIncludes(fragment.Blocks, filepath.Dir(fragmentPath), fragmentStack)
+	}
+	if err != nil {
+		return BlockDSLModel{}, err
+	}
+
+	if include.Slot != "" {
+		fragment.Slot = include.Slot
+	}
+	if include.Prefix != "" {
+		fragment = prefixBlockKeys(fragment, include.Prefix)
+	}
+	return fragment, nil
+}
+
+func substituteIncludeParams(content string, params map[string]string) (string, []string) {
+	var missingParams []string
+	substituted := includeParamPattern.ReplaceAllStringFunc(content, func(match string) string {
+		name := includeParamPattern.FindStringSubmatch(match)[1]
+		value, found := params[name]
+		if !found {
+			missingParams = append(missingParams, name)
+			return match
+		}
+		escaped, _ := json.Marshal(value)
+		return strings.Trim(string(escaped), `"`)
+	})
+	return substituted, missingParams
+}
+
+func resolveIncludePath(include string, baseDir string) (string, error) {
+	if !filepath.IsAbs(include) {
+		include = filepath.Join(baseDir, include)
+	}
+	return filepath.Abs(include)
+}
+
+func parseBlockFragment(path string, content []byte) (BlockDSLModel, error) {
+	if isCompactFrameFile(path) {
+		return parseCompactBlock(content)
+	}
+
+	var fragment BlockDSLModel
+	err := json.Unmarshal(content, &fragment)
+	return fragment, err
+}
+
+func loadBlockFragmentFile(path string) (BlockDSLModel, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return BlockDSLModel{}, fmt.Errorf("failed to read file: %v", err)
+	}
+
+	fragment, err := parseBlockFragment(path, content)
+	if err != nil {
+		return BlockDSLModel{}, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return fragment, nil
+}
+
+func saveBlockFragmentFile(path string, fragment BlockDSLModel) error {
+	var content []byte
+	if isCompactFrameFile(path) {
+		var builder strings.Builder
+		printCompactBlock(&builder, fragment, "")
+		content = []byte(builder.String())
+	} else {
+		var err error
+		content, err = marshalBlockDSL(fragment)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %v", err)
+	}
+	return nil
+}
+
+func findBlockIncludes(blocks []BlockDSLModel, baseDir string) []string {
+	var includes []string
+	for _, block := range blocks {
+		if block.Include == "" {
+			includes = append(includes, findBlockIncludes(block.Blocks, baseDir)...)
+			continue
+		}
+
+		fragmentPath, err := resolveIncludePath(block.Include, baseDir)
+		if err != nil {
+			fragmentPath = block.Include
+		}
+		includes = append(includes, fragmentPath)
+	}
+	return includes
+}
+
+func prefixBlockKeys(block BlockDSLModel, prefix string) BlockDSLModel {
+	block.Key = prefix + block.Key
+
+	actions := make([]ActionDSLModel, len(block.Actions))
+	for i, action := range block.Actions {
+		if action.Key != "" {
+			action.Key = prefix + action.Key
+		}
+		actions[i] = action
+	}
+	block.Actions = actions
+
+	children := make([]BlockDSLModel, len(block.Blocks))
+	for i, child := range block.Blocks {
+		children[i] = prefixBlockKeys(child, prefix)
+	}
+	block.Blocks = children
+	return block
+}
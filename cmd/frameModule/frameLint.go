@@ -0,0 +1,208 @@
+package frameModule
+
+import (
+	"fmt"
+)
+
+const (
+	lintSeverityError   = "error"
+	lintSeverityWarning = "warning"
+)
+
+type frameLintIssue struct {
+	Severity string
+	Path     string
+	Message  string
+}
+
+type frameLinter struct {
+	blocks        map[string]IntegrationSchemaModel
+	actions       map[string]IntegrationSchemaModel
+	variables     map[string]VariableDSLModel
+	usedVariables map[string]bool
+	issues        []frameLintIssue
+}
+
+func lintFrame(frame FrameDSLModel, blocks map[string]IntegrationSchemaModel, actions map[string]IntegrationSchemaModel) []frameLintIssue {
+	linter := &frameLinter{
+		blocks:        blocks,
+		actions:       actions,
+		variables:     make(map[string]VariableDSLModel),
+		usedVariables: make(map[string]bool),
+	}
+
+	for index, variable := range frame.Variables {
+		path := fmt.Sprintf("variables[%d](%s)", index, variable.Key)
+		if _, exists := linter.variables[variable.Key]; exists {
+			linter.addError(path, "duplicate variable key %s", variable.Key)
+			continue
+		}
+		linter.variables[variable.Key] = variable
+	}
+
+	for _, argument := range convertRouteArguments(frame.Route) {
+		linter.usedVariables[argument.Name] = true
+	}
+
+	linter.lintBlocks(frame.Blocks, "", nil)
+
+	for index, variable := range frame.Variables {
+		if !linter.usedVariables[variable.Key] {
+			linter.addWarning(fmt.Sprintf("variables[%d](%s)", index, variable.Key), "variable %s is never used", variable.Key)
+		}
+	}
+
+	return linter.issues
+}
+
+func (linter *frameLinter) addError(path string, format string, args ...interface{}) {
+	linter.issues = append(linter.issues, frameLintIssue{Severity: lintSeverityError, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (linter *frameLinter) addWarning(path string, format string, args ...interface{}) {
+	linter.issues = append(linter.issues, frameLintIssue{Severity: lintSeverityWarning, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (linter *frameLinter) lintBlocks(blocks []BlockDSLModel, parentPath string, parent *BlockDSLModel) {
+	for index, block := range blocks {
+		path := fmt.Sprintf("%sblocks[%d](%s)", parentPath, index, block.Key)
+
+		if parent != nil && parent.KeyType != "ROOT" {
+			slot := block.Slot
+			if slot == "null" {
+				slot = ""
+			}
+			if !containsDSLSlot(parent.Slots, slot) {
+				linter.addError(path, "slot %q is not declared by the parent block %s", slot, parent.Key)
+			}
+		}
+
+		if block.VisibilityKey != "" {
+			linter.usedVariables[block.VisibilityKey] = true
+		}
+
+		integration, found := linter.blocks[block.KeyType]
+		if !found && block.KeyType != "ROOT" {
+			linter.addError(path, "block keyType %s is not installed", block.KeyType)
+		}
+
+		for propertyIndex, property := range block.Properties {
+			propertyPath := fmt.Sprintf("%s.properties[%d](%s)", path, propertyIndex, property.Key)
+			if found {
+				linter.lintProperty(propertyPath, block.KeyType, integration, property.Key, property.Type)
+			}
+		}
+
+		for dataIndex, dataItem := range block.Data {
+			dataPath := fmt.Sprintf("%s.data[%d](%s)", path, dataIndex, dataItem.Key)
+			linter.lintData(dataPath, block.KeyType, integration, found, dataItem.Key, dataItem.Value, dataItem.Type)
+		}
+
+		for slotIndex, slot := range block.Slots {
+			slotPath := fmt.Sprintf("%s.slots[%d](%s)", path, slotIndex, slot.Slot)
+			if found && !containsIntegrationSlot(integration.Slots, slot.Slot) {
+				linter.addError(slotPath, "slot %s is not defined for %s", slot.Slot, block.KeyType)
+			}
+		}
+
+		for actionIndex, action := range block.Actions {
+			actionPath := fmt.Sprintf("%s.actions[%d](%s)", path, actionIndex, action.Event)
+			if found && !containsIntegrationEvent(integration.Events, action.Event) {
+				linter.addError(actionPath, "event %s is not defined for %s", action.Event, block.KeyType)
+			}
+			linter.lintTriggers(action.Triggers, actionPath+".")
+		}
+
+		currentBlock := block
+		linter.lintBlocks(block.Blocks, path+".", &currentBlock)
+	}
+}
+
+func (linter *frameLinter) lintTriggers(triggers []ActionTriggerDSLModel, parentPath string) {
+	for index, trigger := range triggers {
+		path := fmt.Sprintf("%striggers[%d](%s)", parentPath, index, trigger.Name)
+
+		integration, found := linter.actions[trigger.KeyType]
+		if !found {
+			linter.addError(path, "action keyType %s is not installed", trigger.KeyType)
+		}
+
+		for propertyIndex, property := range trigger.Properties {
+			propertyPath := fmt.Sprintf("%s.properties[%d](%s)", path, propertyIndex, property.Key)
+			if found {
+				linter.lintProperty(propertyPath, trigger.KeyType, integration, property.Key, property.Type)
+			}
+		}
+
+		for dataIndex, dataItem := range trigger.Data {
+			dataPath := fmt.Sprintf("%s.data[%d](%s)", path, dataIndex, dataItem.Key)
+			linter.lintData(dataPath, trigger.KeyType, integration, found, dataItem.Key, dataItem.Value, dataItem.Type)
+		}
+
+		linter.lintTriggers(trigger.Triggers, path+".")
+	}
+}
+
+func (linter *frameLinter) lintProperty(path string, keyType string, integration IntegrationSchemaModel, key string, propertyType string) {
+	for _, property := range integration.Properties {
+		if property.Key == key {
+			if property.Type != "" && propertyType != property.Type {
+				linter.addError(path, "property %s of %s must be %s but is %s", key, keyType, property.Type, propertyType)
+			}
+			return
+		}
+	}
+	linter.addError(path, "property %s is not defined for %s", key, keyType)
+}
+
+func (linter *frameLinter) lintData(path string, keyType string, integration IntegrationSchemaModel, found bool, key string, value string, dataType string) {
+	linter.usedVariables[value] = true
+
+	variable, variableFound := linter.variables[value]
+	if !variableFound {
+		linter.addError(path, "no matching variable found for %s", value)
+	} else if variable.Type != dataType {
+		linter.addError(path, "variable %s of type %s is not compatible with data %s of type %s", variable.Key, variable.Type, key, dataType)
+	}
+
+	if !found {
+		return
+	}
+
+	for _, dataItem := range integration.Data {
+		if dataItem.Key == key {
+			if dataItem.Type != "" && dataType != dataItem.Type {
+				linter.addError(path, "data %s of %s must be %s but is %s", key, keyType, dataItem.Type, dataType)
+			}
+			return
+		}
+	}
+	linter.addError(path, "data %s is not defined for %s", key, keyType)
+}
+
+func containsDSLSlot(slots []BlockSlotDSLModel, slot string) bool {
+	for _, item := range slots {
+		if item.Slot == slot {
+			return true
+		}
+	}
+	return false
+}
+
+func containsIntegrationSlot(slots []IntegrationSchemaSlotModel, slot string) bool {
+	for _, item := range slots {
+		if item.Slot == slot {
+			return true
+		}
+	}
+	return false
+}
+
+func containsIntegrationEvent(events []IntegrationSchemaEventModel, event string) bool {
+	for _, item := range events {
+		if item.Event == event {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,361 @@
+package frameModule
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const localeDirName = "locales"
+
+var stringRefPattern = regexp.MustCompile(`^@string/([A-Za-z0-9_.-]+)$`)
+
+var localeKeyPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+type frameLocaleString struct {
+	Key   string
+	Value string
+	Path  string
+}
+
+func findLocaleDir(frameDir string) (string, error) {
+	projectDir := findProjectDir(frameDir)
+	if projectDir == "" {
+		return "", errors.New("could not find the project directory, please run project gen-schema first")
+	}
+	return filepath.Join(projectDir, localeDirName), nil
+}
+
+func loadLocaleBundle(path string) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read locale file: %v", err)
+	}
+
+	bundle := make(map[string]string)
+	if err := json.Unmarshal(content, &bundle); err != nil {
+		return nil, fmt.Errorf("failed to parse locale file %s: %v", path, err)
+	}
+	return bundle, nil
+}
+
+func saveLocaleBundle(path string, bundle map[string]string) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create locale directory: %v", err)
+	}
+
+	content, err := marshalUnescapedJSON(bundle)
+	if err != nil {
+		return err
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, content, "", "  "); err != nil {
+		return err
+	}
+	indented.WriteString("\n")
+	if err := os.WriteFile(path, indented.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write locale file: %v", err)
+	}
+	return nil
+}
+
+func listLocales(localeDir string) ([]string, error) {
+	entries, err := os.ReadDir(localeDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read locale directory: %v", err)
+	}
+
+	var locales []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		locales = append(locales, strings.TrimSuffix(entry.Name(), ".json"))
+	}
+	sort.Strings(locales)
+	return locales, nil
+}
+
+func resolveFrameStrings(frame FrameDSLModel, baseDir string, locale string) (FrameDSLModel, error) {
+	localeDir, err := findLocaleDir(baseDir)
+	if err != nil {
+		return FrameDSLModel{}, err
+	}
+
+	bundle, err := loadLocaleBundle(filepath.Join(localeDir, locale+".json"))
+	if err != nil {
+		return FrameDSLModel{}, err
+	}
+
+	missing := make(map[string]bool)
+	frame = mapFrameStringValues(frame, func(value string) string {
+		match := stringRefPattern.FindStringSubmatch(value)
+		if match == nil {
+			return value
+		}
+		translated, found := bundle[match[1]]
+		if !found {
+			missing[match[1]] = true
+			return value
+		}
+		return translated
+	})
+
+	if len(missing) > 0 {
+		var keys []string
+		for key := range missing {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return FrameDSLModel{}, fmt.Errorf("missing translations for locale %s: %s", locale, strings.Join(keys, ","))
+	}
+	return frame, nil
+}
+
+func findFrameStringRefs(frame FrameDSLModel) []string {
+	found := make(map[string]bool)
+	mapFrameStringValues(frame, func(value string) string {
+		if match := stringRefPattern.FindStringSubmatch(value); match != nil {
+			found[match[1]] = true
+		}
+		return value
+	})
+
+	var keys []string
+	for key := range found {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func mapFrameStringValues(frame FrameDSLModel, mapValue func(value string) string) FrameDSLModel {
+	if frame.Variables != nil {
+		variables := make([]VariableDSLModel, len(frame.Variables))
+		for i, variable := range frame.Variables {
+			variable.Value = mapValue(variable.Value)
+			variables[i] = variable
+		}
+		frame.Variables = variables
+	}
+	frame.Blocks = mapBlockStringValues(frame.Blocks, mapValue)
+	return frame
+}
+
+func mapBlockStringValues(blocks []BlockDSLModel, mapValue func(value string) string) []BlockDSLModel {
+	if blocks == nil {
+		return nil
+	}
+
+	mapped := make([]BlockDSLModel, len(blocks))
+	for i, block := range blocks {
+		if block.Properties != nil {
+			properties := make([]BlockPropertyDSLModel, len(block.Properties))
+			for j, property := range block.Properties {
+				property.Value = mapValue(property.Value)
+				property.ValueMobile = mapValue(property.ValueMobile)
+				property.ValueTablet = mapValue(property.ValueTablet)
+				property.ValueDesktop = mapValue(property.ValueDesktop)
+				properties[j] = property
+			}
+			block.Properties = properties
+		}
+
+		if block.Actions != nil {
+			actions := make([]ActionDSLModel, len(block.Actions))
+			for j, action := range block.Actions {
+				action.Triggers = mapTriggerStringValues(action.Triggers, mapValue)
+				actions[j] = action
+			}
+			block.Actions = actions
+		}
+
+		block.Blocks = mapBlockStringValues(block.Blocks, mapValue)
+		mapped[i] = block
+	}
+	return mapped
+}
+
+func mapTriggerStringValues(triggers []ActionTriggerDSLModel, mapValue func(value string) string) []ActionTriggerDSLModel {
+	if triggers == nil {
+		return nil
+	}
+
+	mapped := make([]ActionTriggerDSLModel, len(triggers))
+	for i, trigger := range triggers {
+		if trigger.Properties != nil {
+			properties := make([]TriggerPropertyDSLModel, len(trigger.Properties))
+			for j, property := range trigger.Properties {
+				property.Value = mapValue(property.Value)
+				properties[j] = property
+			}
+			trigger.Properties = properties
+		}
+
+		trigger.Triggers = mapTriggerStringValues(trigger.Triggers, mapValue)
+		mapped[i] = trigger
+	}
+	return mapped
+}
+
+func findMissingTranslations(locales map[string]map[string]string, refs []string) map[string][]string {
+	expected := make(map[string]bool)
+	for _, key := range refs {
+		expected[key] = true
+	}
+	for _, bundle := range locales {
+		for key := range bundle {
+			expected[key] = true
+		}
+	}
+
+	missing := make(map[string][]string)
+	for locale, bundle := range locales {
+		for key := range expected {
+			if _, found := bundle[key]; !found {
+				missing[locale] = append(missing[locale], key)
+			}
+		}
+		sort.Strings(missing[locale])
+	}
+	return missing
+}
+
+type localeExtractor struct {
+	propertyKeys []string
+	bundle       map[string]string
+	extracted    []frameLocaleString
+}
+
+func (extractor *localeExtractor) extract(key string, value string, path string) string {
+	if value == "" || stringRefPattern.MatchString(value) || placeholderPattern.MatchString(value) || includeParamPattern.MatchString(value) {
+		return value
+	}
+
+	candidate := key
+	for i := 2; ; i++ {
+		existing, found := extractor.bundle[candidate]
+		if !found || existing == value {
+			break
+		}
+		candidate = fmt.Sprintf("%s_%v", key, i)
+	}
+
+	extractor.bundle[candidate] = value
+	extractor.extracted = append(extractor.extracted, frameLocaleString{Key: candidate, Value: value, Path: path})
+	return "@string/" + candidate
+}
+
+func extractFrameStrings(frame FrameDSLModel, propertyKeys []string, bundle map[string]string) (FrameDSLModel, []frameLocaleString) {
+	extractor := &localeExtractor{
+		propertyKeys: propertyKeys,
+		bundle:       bundle,
+	}
+	frame.Blocks = extractBlockStrings(frame.Blocks, "blocks", localeKey(routeSlug(frame.Route)), extractor)
+	return frame, extractor.extracted
+}
+
+func extractBlockFragmentStrings(fragment BlockDSLModel, name string, propertyKeys []string, bundle map[string]string) (BlockDSLModel, []frameLocaleString) {
+	extractor := &localeExtractor{
+		propertyKeys: propertyKeys,
+		bundle:       bundle,
+	}
+	fragment = extractBlockStrings([]BlockDSLModel{fragment}, "blocks", localeKey(name), extractor)[0]
+	return fragment, extractor.extracted
+}
+
+func extractBlockStrings(blocks []BlockDSLModel, path string, prefix string, extractor *localeExtractor) []BlockDSLModel {
+	if blocks == nil {
+		return nil
+	}
+
+	extracted := make([]BlockDSLModel, len(blocks))
+	for i, block := range blocks {
+		blockPath := fmt.Sprintf("%s[%v](%s)", path, i, block.Key)
+		blockKey := prefix + "_" + localeKey(block.Key)
+
+		if block.Properties != nil {
+			properties := make([]BlockPropertyDSLModel, len(block.Properties))
+			for j, property := range block.Properties {
+				if property.Type == "STRING" && containsString(extractor.propertyKeys, property.Key) {
+					property = extractBlockPropertyStrings(property, localeKey(blockKey+"_"+property.Key), fmt.Sprintf("%s.properties[%v](%s)", blockPath, j, property.Key), extractor)
+				}
+				properties[j] = property
+			}
+			block.Properties = properties
+		}
+
+		if block.Actions != nil {
+			actions := make([]ActionDSLModel, len(block.Actions))
+			for j, action := range block.Actions {
+				actionPath := fmt.Sprintf("%s.actions[%v](%s)", blockPath, j, action.Event)
+				action.Triggers = extractTriggerStrings(action.Triggers, actionPath, localeKey(blockKey+"_"+action.Event), extractor)
+				actions[j] = action
+			}
+			block.Actions = actions
+		}
+
+		block.Blocks = extractBlockStrings(block.Blocks, blockPath+".blocks", prefix, extractor)
+		extracted[i] = block
+	}
+	return extracted
+}
+
+func extractBlockPropertyStrings(property BlockPropertyDSLModel, key string, path string, extractor *localeExtractor) BlockPropertyDSLModel {
+	if property.Value != "" {
+		property.Value = extractor.extract(key, property.Value, path)
+		return property
+	}
+
+	if property.ValueMobile == property.ValueTablet && property.ValueMobile == property.ValueDesktop {
+		value := extractor.extract(key, property.ValueMobile, path)
+		property.ValueMobile, property.ValueTablet, property.ValueDesktop = value, value, value
+		return property
+	}
+
+	property.ValueMobile = extractor.extract(key+"_mobile", property.ValueMobile, path+".valueMobile")
+	property.ValueTablet = extractor.extract(key+"_tablet", property.ValueTablet, path+".valueTablet")
+	property.ValueDesktop = extractor.extract(key+"_desktop", property.ValueDesktop, path+".valueDesktop")
+	return property
+}
+
+func extractTriggerStrings(triggers []ActionTriggerDSLModel, path string, prefix string, extractor *localeExtractor) []ActionTriggerDSLModel {
+	if triggers == nil {
+		return nil
+	}
+
+	extracted := make([]ActionTriggerDSLModel, len(triggers))
+	for i, trigger := range triggers {
+		triggerPath := fmt.Sprintf("%s.triggers[%v](%s)", path, i, trigger.Name)
+		triggerKey := localeKey(prefix + "_" + trigger.Name)
+
+		if trigger.Properties != nil {
+			properties := make([]TriggerPropertyDSLModel, len(trigger.Properties))
+			for j, property := range trigger.Properties {
+				if property.Type == "STRING" && containsString(extractor.propertyKeys, property.Key) {
+					property.Value = extractor.extract(localeKey(triggerKey+"_"+property.Key), property.Value, fmt.Sprintf("%s.properties[%v](%s)", triggerPath, j, property.Key))
+				}
+				properties[j] = property
+			}
+			trigger.Properties = properties
+		}
+
+		trigger.Triggers = extractTriggerStrings(trigger.Triggers, triggerPath, prefix, extractor)
+		extracted[i] = trigger
+	}
+	return extracted
+}
+
+func localeKey(name string) string {
+	return strings.Trim(localeKeyPattern.ReplaceAllString(strings.ToLower(name), "_"), "_")
+}
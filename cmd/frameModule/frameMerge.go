@@ -0,0 +1,709 @@
+package frameModule
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	mergeConflictLine   = "line"
+	mergeConflictObject = "object"
+
+	mergeConflictIntBase = -1987650000
+)
+
+type frameMergeConflict struct {
+	Kind   string
+	Token  string
+	Ours   interface{}
+	Theirs interface{}
+}
+
+type frameMerger struct {
+	base      FrameDSLModel
+	ours      FrameDSLModel
+	theirs    FrameDSLModel
+	conflicts []frameMergeConflict
+}
+
+type flatBlockDSL struct {
+	Block     BlockDSLModel
+	ParentKey string
+	Position  int
+}
+
+const (
+	mergeFormatJSON    = "json"
+	mergeFormatCompact = "nbf"
+)
+
+var mergeTrailingCommaPattern = regexp.MustCompile(`,(\n\s*[\]}])`)
+
+func isCompactFrameContent(content []byte) bool {
+	return !bytes.HasPrefix(bytes.TrimSpace(content), []byte("{"))
+}
+
+// loadMergeFrame detects the syntax from the content, git passes temporary files without
+// an extension to a merge driver.
+func loadMergeFrame(path string) (FrameDSLModel, bool, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return FrameDSLModel{}, false, fmt.Errorf("failed to read file: %v", err)
+	}
+	if len(bytes.TrimSpace(content)) == 0 {
+		return FrameDSLModel{}, false, nil
+	}
+
+	if isCompactFrameContent(content) {
+		frame, _, err := parseCompactFrame(content)
+		if err != nil {
+			return FrameDSLModel{}, true, fmt.Errorf("failed to parse %s: %v", path, err)
+		}
+		return frame, true, nil
+	}
+
+	var frame FrameDSLModel
+	if err := json.Unmarshal(content, &frame); err != nil {
+		return FrameDSLModel{}, false, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return frame, false, nil
+}
+
+// loadMergeBase treats a missing or empty base as an empty frame, git passes an empty
+// file when both sides added the frame.
+func loadMergeBase(path string) (FrameDSLModel, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return FrameDSLModel{}, nil
+	}
+	frame, _, err := loadMergeFrame(path)
+	return frame, err
+}
+
+func mergeFrames(base FrameDSLModel, ours FrameDSLModel, theirs FrameDSLModel, compact bool) ([]byte, int, error) {
+	merger := &frameMerger{
+		base:   base,
+		ours:   ours,
+		theirs: theirs,
+	}
+
+	merged := FrameDSLModel{
+		Schema:    merger.mergeString(base.Schema, ours.Schema, theirs.Schema),
+		Name:      merger.mergeString(base.Name, ours.Name, theirs.Name),
+		Route:     merger.mergeString(base.Route, ours.Route, theirs.Route),
+		Type:      merger.mergeString(base.Type, ours.Type, theirs.Type),
+		IsStarter: mergeBool(base.IsStarter, ours.IsStarter, theirs.IsStarter),
+	}
+
+	merged.Variables = mergeKeyedElements(merger, base.Variables, ours.Variables, theirs.Variables,
+		func(variable VariableDSLModel) string { return variable.Key },
+		func(token string) VariableDSLModel { return VariableDSLModel{Key: token} },
+	)
+
+	merged.Blocks = merger.mergeBlocks()
+
+	content, err := marshalFrameDSL(merged)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if compact {
+		return merger.renderCompactConflicts(content)
+	}
+
+	content, err = merger.renderConflicts(content, markMergeConflict)
+	if err != nil {
+		return nil, 0, err
+	}
+	return content, bytes.Count(content, []byte("<<<<<<< ours\n")), nil
+}
+
+func markMergeConflict(ours []string, theirs []string) []string {
+	marked := []string{"<<<<<<< ours"}
+	marked = append(marked, ours...)
+	marked = append(marked, "=======")
+	marked = append(marked, theirs...)
+	return append(marked, ">>>>>>> theirs")
+}
+
+func pickOursConflict(ours []string, theirs []string) []string {
+	return ours
+}
+
+func pickTheirsConflict(ours []string, theirs []string) []string {
+	return theirs
+}
+
+func threeWay[T any](base T, ours T, theirs T) (T, bool) {
+	if reflect.DeepEqual(ours, theirs) {
+		return ours, false
+	}
+	if reflect.DeepEqual(base, ours) {
+		return theirs, false
+	}
+	if reflect.DeepEqual(base, theirs) {
+		return ours, false
+	}
+	return ours, true
+}
+
+func mergeBool(base bool, ours bool, theirs bool) bool {
+	merged, _ := threeWay(base, ours, theirs)
+	return merged
+}
+
+func (merger *frameMerger) mergeString(base string, ours string, theirs string) string {
+	merged, conflict := threeWay(base, ours, theirs)
+	if !conflict {
+		return merged
+	}
+
+	token := fmt.Sprintf("__nb_conflict_%d__", len(merger.conflicts))
+	merger.conflicts = append(merger.conflicts, frameMergeConflict{Kind: mergeConflictLine, Token: strconv.Quote(token), Ours: ours, Theirs: theirs})
+	return token
+}
+
+func (merger *frameMerger) mergeInt(base int, ours int, theirs int) int {
+	merged, conflict := threeWay(base, ours, theirs)
+	if !conflict {
+		return merged
+	}
+
+	value := mergeConflictIntBase - len(merger.conflicts)
+	merger.conflicts = append(merger.conflicts, frameMergeConflict{Kind: mergeConflictLine, Token: strconv.Itoa(value), Ours: ours, Theirs: theirs})
+	return value
+}
+
+func (merger *frameMerger) objectConflict(ours interface{}, theirs interface{}) string {
+	token := fmt.Sprintf("__nb_conflict_%d__", len(merger.conflicts))
+	merger.conflicts = append(merger.conflicts, frameMergeConflict{Kind: mergeConflictObject, Token: strconv.Quote(token), Ours: ours, Theirs: theirs})
+	return token
+}
+
+func mergeKeyedElements[T any](merger *frameMerger, base []T, ours []T, theirs []T, keyOf func(T) string, placeholder func(token string) T) []T {
+	if base == nil && ours == nil && theirs == nil {
+		return nil
+	}
+
+	baseItems := indexKeyedElements(base, keyOf)
+	oursItems := indexKeyedElements(ours, keyOf)
+	theirsItems := indexKeyedElements(theirs, keyOf)
+
+	var keys []string
+	seen := make(map[string]bool)
+	for _, items := range [][]T{ours, theirs} {
+		for _, item := range items {
+			if key := keyOf(item); !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	merged := make([]T, 0, len(keys))
+	for _, key := range keys {
+		baseItem, inBase := baseItems[key]
+		oursItem, inOurs := oursItems[key]
+		theirsItem, inTheirs := theirsItems[key]
+
+		switch {
+		case inOurs && inTheirs:
+			if !inBase {
+				if reflect.DeepEqual(oursItem, theirsItem) {
+					merged = append(merged, oursItem)
+				} else {
+					merged = append(merged, placeholder(merger.objectConflict(oursItem, theirsItem)))
+				}
+				continue
+			}
+			item, conflict := threeWay(baseItem, oursItem, theirsItem)
+			if conflict {
+				item = placeholder(merger.objectConflict(oursItem, theirsItem))
+			}
+			merged = append(merged, item)
+		case inOurs:
+			if !inBase {
+				merged = append(merged, oursItem)
+			} else if !reflect.DeepEqual(baseItem, oursItem) {
+				merged = append(merged, placeholder(merger.objectConflict(oursItem, nil)))
+			}
+		case inTheirs:
+			if !inBase {
+				merged = append(merged, theirsItem)
+			} else if !reflect.DeepEqual(baseItem, theirsItem) {
+				merged = append(merged, placeholder(merger.objectConflict(nil, theirsItem)))
+			}
+		}
+	}
+	return merged
+}
+
+func indexKeyedElements[T any](items []T, keyOf func(T) string) map[string]T {
+	indexed := make(map[string]T)
+	for _, item := range items {
+		indexed[keyOf(item)] = item
+	}
+	return indexed
+}
+
+func blockIdentity(block BlockDSLModel) string {
+	if block.Include != "" {
+		return "$include:" + block.Include + "|" + block.Prefix
+	}
+	return block.Key
+}
+
+func flattenBlocksDSL(blocks []BlockDSLModel, parentKey string, flat map[string]flatBlockDSL, order *[]string) {
+	for index, block := range blocks {
+		identity := blockIdentity(block)
+		children := block.Blocks
+		block.Blocks = nil
+		flat[identity] = flatBlockDSL{Block: block, ParentKey: parentKey, Position: index}
+		*order = append(*order, identity)
+		flattenBlocksDSL(children, identity, flat, order)
+	}
+}
+
+func findBlockByIdentity(blocks []BlockDSLModel, identity string) *BlockDSLModel {
+	for i := range blocks {
+		if blockIdentity(blocks[i]) == identity {
+			return &blocks[i]
+		}
+		if found := findBlockByIdentity(blocks[i].Blocks, identity); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func (merger *frameMerger) mergeBlocks() []BlockDSLModel {
+	baseBlocks := make(map[string]flatBlockDSL)
+	oursBlocks := make(map[string]flatBlockDSL)
+	theirsBlocks := make(map[string]flatBlockDSL)
+	var baseOrder, oursOrder, theirsOrder []string
+	flattenBlocksDSL(merger.base.Blocks, "", baseBlocks, &baseOrder)
+	flattenBlocksDSL(merger.ours.Blocks, "", oursBlocks, &oursOrder)
+	flattenBlocksDSL(merger.theirs.Blocks, "", theirsBlocks, &theirsOrder)
+
+	var order []string
+	seen := make(map[string]bool)
+	for _, identity := range append(oursOrder, theirsOrder...) {
+		if !seen[identity] {
+			seen[identity] = true
+			order = append(order, identity)
+		}
+	}
+
+	merged := make(map[string]flatBlockDSL)
+	for _, identity := range order {
+		baseBlock, inBase := baseBlocks[identity]
+		oursBlock, inOurs := oursBlocks[identity]
+		theirsBlock, inTheirs := theirsBlocks[identity]
+
+		switch {
+		case inOurs && inTheirs:
+			if !inBase {
+				if reflect.DeepEqual(oursBlock.Block, theirsBlock.Block) && oursBlock.ParentKey == theirsBlock.ParentKey {
+					merged[identity] = oursBlock
+				} else {
+					merged[identity] = merger.blockConflict(identity, oursBlock, true, true)
+				}
+				continue
+			}
+			merged[identity] = merger.mergeBlock(identity, baseBlock, oursBlock, theirsBlock)
+		case inOurs:
+			if !inBase {
+				merged[identity] = oursBlock
+			} else if !reflect.DeepEqual(baseBlock.Block, oursBlock.Block) {
+				merged[identity] = merger.blockConflict(identity, oursBlock, true, false)
+			}
+		case inTheirs:
+			if !inBase {
+				merged[identity] = theirsBlock
+			} else if !reflect.DeepEqual(baseBlock.Block, theirsBlock.Block) {
+				merged[identity] = merger.blockConflict(identity, theirsBlock, false, true)
+			}
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, identity := range order {
+			block, found := merged[identity]
+			if !found || block.ParentKey == "" {
+				continue
+			}
+			if _, parentFound := merged[block.ParentKey]; parentFound {
+				continue
+			}
+
+			parentKey := block.ParentKey
+			if parent, inOurs := oursBlocks[parentKey]; inOurs {
+				merged[parentKey] = merger.blockConflict(parentKey, parent, true, false)
+			} else if parent, inTheirs := theirsBlocks[parentKey]; inTheirs {
+				merged[parentKey] = merger.blockConflict(parentKey, parent, false, true)
+			} else {
+				block.ParentKey = ""
+				merged[identity] = block
+			}
+			order = append(order, parentKey)
+			changed = true
+		}
+	}
+
+	children := make(map[string][]string)
+	for _, parentKey := range append([]string{""}, order...) {
+		if _, done := children[parentKey]; done {
+			continue
+		}
+		if _, found := merged[parentKey]; !found && parentKey != "" {
+			continue
+		}
+
+		siblings, conflict := mergeSiblingOrder(
+			findMergedSiblings(baseBlocks, baseOrder, merged, parentKey),
+			findMergedSiblings(oursBlocks, oursOrder, merged, parentKey),
+			findMergedSiblings(theirsBlocks, theirsOrder, merged, parentKey),
+		)
+		for _, identity := range order {
+			if block, found := merged[identity]; found && block.ParentKey == parentKey && !slices.Contains(siblings, identity) {
+				siblings = append(siblings, identity)
+			}
+		}
+
+		if conflict && parentKey == "" {
+			token := merger.objectConflict(merger.ours.Blocks, merger.theirs.Blocks)
+			merged[token] = flatBlockDSL{Block: BlockDSLModel{Key: token}}
+			children[token] = nil
+			siblings = []string{token}
+		} else if conflict {
+			merged[parentKey] = merger.blockConflict(parentKey, merged[parentKey], true, true)
+		}
+		children[parentKey] = siblings
+	}
+
+	return buildMergedBlockTree(merged, children, "")
+}
+
+func findMergedSiblings(flat map[string]flatBlockDSL, order []string, merged map[string]flatBlockDSL, parentKey string) []string {
+	var siblings []string
+	for _, identity := range order {
+		block, found := flat[identity]
+		mergedBlock, kept := merged[identity]
+		if found && kept && block.ParentKey == parentKey && mergedBlock.ParentKey == parentKey {
+			siblings = append(siblings, identity)
+		}
+	}
+	return siblings
+}
+
+// mergeSiblingOrder merges the order of the children of one block. Siblings kept by both sides follow the
+// side that reordered them, siblings only one side has are placed after the sibling they follow on that side.
+// Both sides reordering the same siblings, or adding siblings at the same place, is a conflict.
+func mergeSiblingOrder(base []string, ours []string, theirs []string) ([]string, bool) {
+	inOurs := make(map[string]bool)
+	for _, identity := range ours {
+		inOurs[identity] = true
+	}
+	inTheirs := make(map[string]bool)
+	for _, identity := range theirs {
+		inTheirs[identity] = true
+	}
+	common := func(siblings []string) []string {
+		var kept []string
+		for _, identity := range siblings {
+			if inOurs[identity] && inTheirs[identity] {
+				kept = append(kept, identity)
+			}
+		}
+		return kept
+	}
+
+	skeleton, conflict := threeWay(common(base), common(ours), common(theirs))
+	if conflict {
+		return ours, true
+	}
+
+	inserted := make(map[string][2][]string)
+	for side, siblings := range [][]string{ours, theirs} {
+		anchor := ""
+		for _, identity := range siblings {
+			if inOurs[identity] && inTheirs[identity] {
+				anchor = identity
+				continue
+			}
+			added := inserted[anchor]
+			added[side] = append(added[side], identity)
+			inserted[anchor] = added
+		}
+	}
+
+	var merged []string
+	for _, anchor := range append([]string{""}, skeleton...) {
+		if anchor != "" {
+			merged = append(merged, anchor)
+		}
+		added := inserted[anchor]
+		if len(added[0]) > 0 && len(added[1]) > 0 {
+			return ours, true
+		}
+		merged = append(merged, added[0]...)
+		merged = append(merged, added[1]...)
+	}
+	return merged, false
+}
+
+func (merger *frameMerger) blockConflict(identity string, block flatBlockDSL, fromOurs bool, fromTheirs bool) flatBlockDSL {
+	var ours, theirs interface{}
+	if fromOurs {
+		ours = findBlockByIdentity(merger.ours.Blocks, identity)
+	}
+	if fromTheirs {
+		theirs = findBlockByIdentity(merger.theirs.Blocks, identity)
+	}
+
+	token := merger.objectConflict(ours, theirs)
+	return flatBlockDSL{
+		Block:     BlockDSLModel{Key: strings.Trim(token, "\"")},
+		ParentKey: block.ParentKey,
+	}
+}
+
+func (merger *frameMerger) mergeBlock(identity string, base flatBlockDSL, ours flatBlockDSL, theirs flatBlockDSL) flatBlockDSL {
+	parentKey, conflict := threeWay(base.ParentKey, ours.ParentKey, theirs.ParentKey)
+	if conflict || ours.Block.Include != "" || theirs.Block.Include != "" {
+		if reflect.DeepEqual(ours.Block, theirs.Block) && !conflict {
+			ours.ParentKey = parentKey
+			return ours
+		}
+		if item, itemConflict := threeWay(base.Block, ours.Block, theirs.Block); !itemConflict && !conflict {
+			return flatBlockDSL{Block: item, ParentKey: parentKey}
+		}
+		return merger.blockConflict(identity, ours, true, true)
+	}
+
+	baseBlock, oursBlock, theirsBlock := base.Block, ours.Block, theirs.Block
+
+	block := BlockDSLModel{
+		KeyType:            merger.mergeString(baseBlock.KeyType, oursBlock.KeyType, theirsBlock.KeyType),
+		Key:                oursBlock.Key,
+		VisibilityKey:      merger.mergeString(baseBlock.VisibilityKey, oursBlock.VisibilityKey, theirsBlock.VisibilityKey),
+		Slot:               merger.mergeString(baseBlock.Slot, oursBlock.Slot, theirsBlock.Slot),
+		IntegrationVersion: merger.mergeInt(baseBlock.IntegrationVersion, oursBlock.IntegrationVersion, theirsBlock.IntegrationVersion),
+	}
+
+	block.Data = mergeKeyedElements(merger, baseBlock.Data, oursBlock.Data, theirsBlock.Data,
+		func(data BlockDataDSLModel) string { return data.Key },
+		func(token string) BlockDataDSLModel { return BlockDataDSLModel{Key: token} },
+	)
+	block.Properties = mergeKeyedElements(merger, baseBlock.Properties, oursBlock.Properties, theirsBlock.Properties,
+		func(property BlockPropertyDSLModel) string { return property.Key },
+		func(token string) BlockPropertyDSLModel { return BlockPropertyDSLModel{Key: token} },
+	)
+	block.Slots = mergeSlots(baseBlock.Slots, oursBlock.Slots, theirsBlock.Slots)
+	block.Actions = mergeKeyedElements(merger, baseBlock.Actions, oursBlock.Actions, theirsBlock.Actions,
+		func(action ActionDSLModel) string { return action.Event },
+		func(token string) ActionDSLModel { return ActionDSLModel{Key: token} },
+	)
+
+	return flatBlockDSL{Block: block, ParentKey: parentKey}
+}
+
+func mergeSlots(base []BlockSlotDSLModel, ours []BlockSlotDSLModel, theirs []BlockSlotDSLModel) []BlockSlotDSLModel {
+	if base == nil && ours == nil && theirs == nil {
+		return nil
+	}
+
+	merged := []BlockSlotDSLModel{}
+	seen := make(map[string]bool)
+	for _, slot := range append(append([]BlockSlotDSLModel{}, ours...), theirs...) {
+		if seen[slot.Slot] {
+			continue
+		}
+		seen[slot.Slot] = true
+
+		inBase := containsDSLSlot(base, slot.Slot)
+		inOurs := containsDSLSlot(ours, slot.Slot)
+		inTheirs := containsDSLSlot(theirs, slot.Slot)
+		if (inOurs && inTheirs) || (!inBase && (inOurs || inTheirs)) {
+			merged = append(merged, slot)
+		}
+	}
+	return merged
+}
+
+func buildMergedBlockTree(merged map[string]flatBlockDSL, children map[string][]string, parentKey string) []BlockDSLModel {
+	var blocks []BlockDSLModel
+	for _, identity := range children[parentKey] {
+		block := merged[identity].Block
+		block.Blocks = buildMergedBlockTree(merged, children, identity)
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+func (merger *frameMerger) renderConflicts(content []byte, resolve func(ours []string, theirs []string) []string) ([]byte, error) {
+	lines := strings.Split(string(content), "\n")
+
+	for _, conflict := range merger.conflicts {
+		index := -1
+		for i, line := range lines {
+			if strings.Contains(line, conflict.Token) {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			continue
+		}
+
+		var ours, theirs []string
+		start, end := index, index
+		if conflict.Kind == mergeConflictLine {
+			oursValue, err := json.Marshal(conflict.Ours)
+			if err != nil {
+				return nil, err
+			}
+			theirsValue, err := json.Marshal(conflict.Theirs)
+			if err != nil {
+				return nil, err
+			}
+			ours = []string{strings.Replace(lines[index], conflict.Token, string(oursValue), 1)}
+			theirs = []string{strings.Replace(lines[index], conflict.Token, string(theirsValue), 1)}
+		} else {
+			indent := lines[index][:len(lines[index])-len(strings.TrimLeft(lines[index], " "))]
+			if len(indent) >= 2 {
+				indent = indent[:len(indent)-2]
+			}
+			for start > 0 && lines[start] != indent+"{" {
+				start--
+			}
+			for end < len(lines)-1 && lines[end] != indent+"}" && lines[end] != indent+"}," {
+				end++
+			}
+			comma := strings.HasSuffix(lines[end], ",")
+
+			var err error
+			ours, err = renderMergeElement(conflict.Ours, indent, comma)
+			if err != nil {
+				return nil, err
+			}
+			theirs, err = renderMergeElement(conflict.Theirs, indent, comma)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var replaced []string
+		replaced = append(replaced, lines[:start]...)
+		replaced = append(replaced, resolve(ours, theirs)...)
+		replaced = append(replaced, lines[end+1:]...)
+		lines = replaced
+	}
+
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// renderCompactConflicts prints the frame once with every conflict resolved to ours and once
+// to theirs, the lines that differ between both prints are the conflicts.
+func (merger *frameMerger) renderCompactConflicts(content []byte) ([]byte, int, error) {
+	var sides [2][]string
+	for i, resolve := range []func(ours []string, theirs []string) []string{pickOursConflict, pickTheirsConflict} {
+		resolved, err := merger.renderConflicts(content, resolve)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var frame FrameDSLModel
+		if err := json.Unmarshal(mergeTrailingCommaPattern.ReplaceAll(resolved, []byte("$1")), &frame); err != nil {
+			return nil, 0, fmt.Errorf("failed to render the merged frame: %v", err)
+		}
+		sides[i] = strings.Split(string(printCompactFrame(frame)), "\n")
+	}
+
+	lines, conflicts := markLineConflicts(sides[0], sides[1])
+	return []byte(strings.Join(lines, "\n")), conflicts, nil
+}
+
+func markLineConflicts(ours []string, theirs []string) ([]string, int) {
+	common := make([][]int, len(ours)+1)
+	for i := range common {
+		common[i] = make([]int, len(theirs)+1)
+	}
+	for i := len(ours) - 1; i >= 0; i-- {
+		for j := len(theirs) - 1; j >= 0; j-- {
+			if ours[i] == theirs[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	var lines, oursHunk, theirsHunk []string
+	conflicts := 0
+	flush := func() {
+		if len(oursHunk) == 0 && len(theirsHunk) == 0 {
+			return
+		}
+		lines = append(lines, markMergeConflict(oursHunk, theirsHunk)...)
+		oursHunk, theirsHunk = nil, nil
+		conflicts++
+	}
+
+	i, j := 0, 0
+	for i < len(ours) || j < len(theirs) {
+		switch {
+		case i < len(ours) && j < len(theirs) && ours[i] == theirs[j]:
+			flush()
+			lines = append(lines, ours[i])
+			i++
+			j++
+		case j == len(theirs) || (i < len(ours) && common[i+1][j] >= common[i][j+1]):
+			oursHunk = append(oursHunk, ours[i])
+			i++
+		default:
+			theirsHunk = append(theirsHunk, theirs[j])
+			j++
+		}
+	}
+	flush()
+	return lines, conflicts
+}
+
+func renderMergeElement(element interface{}, indent string, comma bool) ([]string, error) {
+	if element == nil || (reflect.ValueOf(element).Kind() == reflect.Ptr && reflect.ValueOf(element).IsNil()) {
+		return nil, nil
+	}
+	if value := reflect.ValueOf(element); value.Kind() == reflect.Slice {
+		var rendered []string
+		for i := 0; i < value.Len(); i++ {
+			lines, err := renderMergeElement(value.Index(i).Interface(), indent, comma || i < value.Len()-1)
+			if err != nil {
+				return nil, err
+			}
+			rendered = append(rendered, lines...)
+		}
+		return rendered, nil
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent(indent, "  ")
+	if err := encoder.Encode(element); err != nil {
+		return nil, err
+	}
+
+	rendered := indent + strings.TrimRight(buffer.String(), "\n")
+	if comma {
+		rendered += ","
+	}
+	return strings.Split(rendered, "\n"), nil
+}
@@ -0,0 +1,241 @@
+package frameModule
+
+import (
+	"strings"
+	"testing"
+)
+
+const mergeTestBase = `frame "Page" route=/p type=FRAME
+block ROOT root
+  block COLUMN column slot=content version=1
+    block BUTTON b1 slot=content version=1
+      prop text STRING Hello
+    block BUTTON b2 slot=content version=1
+      prop text STRING Two
+`
+
+func parseMergeTestFrame(t *testing.T, content string) FrameDSLModel {
+	t.Helper()
+	frame, _, err := parseCompactFrame([]byte(content))
+	if err != nil {
+		t.Fatalf("failed to parse frame: %v", err)
+	}
+	return frame
+}
+
+func TestMergeFrames(t *testing.T) {
+	tests := []struct {
+		name      string
+		ours      string
+		theirs    string
+		conflicts int
+		contains  []string
+		excludes  []string
+	}{
+		{
+			name:   "both sides edit different fields",
+			ours:   strings.Replace(mergeTestBase, "STRING Hello", "STRING Hi", 1),
+			theirs: strings.Replace(mergeTestBase, "STRING Two", "STRING Second", 1),
+			contains: []string{
+				`"valueMobile": "Hi"`,
+				`"valueMobile": "Second"`,
+			},
+		},
+		{
+			name:      "both sides edit the same field",
+			ours:      strings.Replace(mergeTestBase, "STRING Hello", "STRING Hi", 1),
+			theirs:    strings.Replace(mergeTestBase, "STRING Hello", "STRING Hey", 1),
+			conflicts: 1,
+			contains: []string{
+				`"valueMobile": "Hi"`,
+				`"valueMobile": "Hey"`,
+			},
+		},
+		{
+			name:      "one side deletes a block the other side modifies",
+			ours:      strings.Replace(mergeTestBase, "    block BUTTON b2 slot=content version=1\n      prop text STRING Two\n", "", 1),
+			theirs:    strings.Replace(mergeTestBase, "STRING Two", "STRING Second", 1),
+			conflicts: 1,
+			contains:  []string{`"valueMobile": "Second"`},
+		},
+		{
+			name:     "one side deletes an unchanged block",
+			ours:     strings.Replace(mergeTestBase, "    block BUTTON b2 slot=content version=1\n      prop text STRING Two\n", "", 1),
+			theirs:   strings.Replace(mergeTestBase, "STRING Hello", "STRING Hi", 1),
+			contains: []string{`"valueMobile": "Hi"`},
+			excludes: []string{`"key": "b2"`},
+		},
+		{
+			name: "one side deletes the parent of a block the other side adds",
+			ours: strings.Replace(mergeTestBase,
+				"  block COLUMN column slot=content version=1\n    block BUTTON b1 slot=content version=1\n      prop text STRING Hello\n    block BUTTON b2 slot=content version=1\n      prop text STRING Two\n", "", 1),
+			theirs:    mergeTestBase + "    block BUTTON b3 slot=content version=1\n",
+			conflicts: 1,
+			contains:  []string{`"key": "b3"`},
+		},
+		{
+			name:   "both sides add blocks at different places",
+			ours:   strings.Replace(mergeTestBase, "    block BUTTON b2", "    block BUTTON b3 slot=content version=1\n    block BUTTON b2", 1),
+			theirs: mergeTestBase + "    block BUTTON b4 slot=content version=1\n",
+			contains: []string{
+				`"key": "b3"`,
+				`"key": "b4"`,
+			},
+		},
+		{
+			name:      "both sides add blocks at the same place",
+			ours:      mergeTestBase + "    block BUTTON b3 slot=content version=1\n",
+			theirs:    mergeTestBase + "    block BUTTON b4 slot=content version=1\n",
+			conflicts: 1,
+		},
+		{
+			name:   "one side reorders siblings",
+			ours:   strings.Replace(mergeTestBase, "STRING Hello", "STRING Hi", 1),
+			theirs: "frame \"Page\" route=/p type=FRAME\nblock ROOT root\n  block COLUMN column slot=content version=1\n    block BUTTON b2 slot=content version=1\n      prop text STRING Two\n    block BUTTON b1 slot=content version=1\n      prop text STRING Hello\n",
+			contains: []string{
+				`"valueMobile": "Hi"`,
+			},
+		},
+		{
+			name:      "both sides add root blocks at the same place",
+			ours:      mergeTestBase + "block COLUMN footer slot=null version=1\n",
+			theirs:    mergeTestBase + "block COLUMN header slot=null version=1\n",
+			conflicts: 1,
+			contains: []string{
+				`"key": "footer"`,
+				`"key": "header"`,
+			},
+		},
+		{
+			name:      "both sides reorder siblings differently",
+			ours:      mergeTestBase + "    block BUTTON b3 slot=content version=1\n",
+			theirs:    strings.Replace(mergeTestBase, "    block BUTTON b1", "    block BUTTON b3 slot=content version=1\n    block BUTTON b1", 1),
+			conflicts: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			base := parseMergeTestFrame(t, mergeTestBase)
+			ours := parseMergeTestFrame(t, test.ours)
+			theirs := parseMergeTestFrame(t, test.theirs)
+
+			merged, conflicts, err := mergeFrames(base, ours, theirs, false)
+			if err != nil {
+				t.Fatalf("merge failed: %v", err)
+			}
+			if conflicts != test.conflicts {
+				t.Fatalf("expected %v conflicts, got %v:\n%s", test.conflicts, conflicts, merged)
+			}
+			if strings.Contains(string(merged), "__nb_conflict_") {
+				t.Fatalf("unresolved conflict token in merged frame:\n%s", merged)
+			}
+			for _, expected := range test.contains {
+				if !strings.Contains(string(merged), expected) {
+					t.Errorf("expected merged frame to contain %s:\n%s", expected, merged)
+				}
+			}
+			for _, unexpected := range test.excludes {
+				if strings.Contains(string(merged), unexpected) {
+					t.Errorf("expected merged frame not to contain %s:\n%s", unexpected, merged)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeFramesSiblingOrder(t *testing.T) {
+	base := parseMergeTestFrame(t, mergeTestBase)
+	ours := parseMergeTestFrame(t, strings.Replace(mergeTestBase, "    block BUTTON b2", "    block BUTTON b3 slot=content version=1\n    block BUTTON b2", 1))
+	theirs := parseMergeTestFrame(t, mergeTestBase+"    block BUTTON b4 slot=content version=1\n")
+
+	merged, conflicts, err := mergeFrames(base, ours, theirs, true)
+	if err != nil {
+		t.Fatalf("merge failed: %v", err)
+	}
+	if conflicts != 0 {
+		t.Fatalf("expected no conflicts, got %v:\n%s", conflicts, merged)
+	}
+
+	frame := parseMergeTestFrame(t, string(merged))
+	var keys []string
+	for _, block := range frame.Blocks[0].Blocks[0].Blocks {
+		keys = append(keys, block.Key)
+	}
+	if strings.Join(keys, ",") != "b1,b3,b2,b4" {
+		t.Fatalf("expected siblings b1,b3,b2,b4, got %v", keys)
+	}
+}
+
+func TestMergeFramesCompactConflicts(t *testing.T) {
+	base := parseMergeTestFrame(t, mergeTestBase)
+	ours := parseMergeTestFrame(t, strings.Replace(mergeTestBase, "STRING Hello", "STRING Hi", 1))
+	theirs := parseMergeTestFrame(t, strings.Replace(mergeTestBase, "STRING Hello", "STRING \"Hey there\"", 1))
+
+	merged, conflicts, err := mergeFrames(base, ours, theirs, true)
+	if err != nil {
+		t.Fatalf("merge failed: %v", err)
+	}
+	if conflicts != 1 {
+		t.Fatalf("expected 1 conflict, got %v:\n%s", conflicts, merged)
+	}
+
+	expected := strings.Join([]string{
+		"<<<<<<< ours",
+		"      prop text STRING Hi",
+		"=======",
+		`      prop text STRING "Hey there"`,
+		">>>>>>> theirs",
+	}, "\n")
+	if !strings.Contains(string(merged), expected) {
+		t.Fatalf("expected conflict markers around the property:\n%s", merged)
+	}
+
+	resolved := strings.Replace(string(merged), expected, "      prop text STRING Hi", 1)
+	if _, _, err := parseCompactFrame([]byte(resolved)); err != nil {
+		t.Fatalf("resolved frame does not parse: %v\n%s", err, resolved)
+	}
+}
+
+func TestMarkLineConflicts(t *testing.T) {
+	tests := []struct {
+		name      string
+		ours      []string
+		theirs    []string
+		expected  []string
+		conflicts int
+	}{
+		{
+			name:     "equal",
+			ours:     []string{"a", "b"},
+			theirs:   []string{"a", "b"},
+			expected: []string{"a", "b"},
+		},
+		{
+			name:      "changed line",
+			ours:      []string{"a", "b", "c"},
+			theirs:    []string{"a", "x", "c"},
+			expected:  []string{"a", "<<<<<<< ours", "b", "=======", "x", ">>>>>>> theirs", "c"},
+			conflicts: 1,
+		},
+		{
+			name:      "added line",
+			ours:      []string{"a", "c"},
+			theirs:    []string{"a", "b", "c"},
+			expected:  []string{"a", "<<<<<<< ours", "=======", "b", ">>>>>>> theirs", "c"},
+			conflicts: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lines, conflicts := markLineConflicts(test.ours, test.theirs)
+			if conflicts != test.conflicts {
+				t.Fatalf("expected %v conflicts, got %v", test.conflicts, conflicts)
+			}
+			if strings.Join(lines, "\n") != strings.Join(test.expected, "\n") {
+				t.Fatalf("expected:\n%s\ngot:\n%s", strings.Join(test.expected, "\n"), strings.Join(lines, "\n"))
+			}
+		})
+	}
+}
@@ -3,6 +3,9 @@ package frameModule
 type FrameWrapper struct {
 	Frame FrameModel `json:"frame"`
 }
+type FramesWrapper struct {
+	Frames []FrameModel `json:"frames"`
+}
 type FrameProductionWrapper struct {
 	FrameProduction FrameModel `json:"frameProduction"`
 }
@@ -26,6 +29,7 @@ type FrameModel struct {
 
 type RouteArgument struct {
 	Name string `json:"name"`
+	Type string `json:"-"`
 }
 
 type VariableModel struct {
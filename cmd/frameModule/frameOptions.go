@@ -0,0 +1,100 @@
+package frameModule
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+type frameGenerateOptions struct {
+	BaseDir            string
+	Values             map[string]string
+	FillDefaults       bool
+	Blocks             map[string]IntegrationSchemaModel
+	Actions            map[string]IntegrationSchemaModel
+	StrictDeprecations bool
+	Locale             string
+	Warnings           io.Writer
+}
+
+type frameGenerateFlags struct {
+	envFile      string
+	values       []string
+	fillDefaults bool
+	locale       string
+}
+
+func (flags *frameGenerateFlags) bind(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&flags.envFile, "env-file", "", "Env file with KEY=VALUE lines for ${KEY} placeholders")
+	cmd.Flags().StringArrayVar(&flags.values, "set", []string{}, "Value for a ${KEY} placeholder as KEY=VALUE, can be repeated")
+	cmd.Flags().BoolVar(&flags.fillDefaults, "fill-defaults", false, "Fill missing block and trigger properties with the integration default values")
+	cmd.Flags().StringVar(&flags.locale, "locale", "", "Locale used to resolve @string/key values from the project locales directory")
+}
+
+func (flags *frameGenerateFlags) options(baseDir string) (frameGenerateOptions, error) {
+	values := make(map[string]string)
+
+	if flags.envFile != "" {
+		envValues, err := loadEnvFile(flags.envFile)
+		if err != nil {
+			return frameGenerateOptions{}, err
+		}
+		for key, value := range envValues {
+			values[key] = value
+		}
+	}
+
+	for _, item := range flags.values {
+		key, value, found := strings.Cut(item, "=")
+		if !found || strings.TrimSpace(key) == "" {
+			return frameGenerateOptions{}, fmt.Errorf("invalid --set value %q, it must be KEY=VALUE", item)
+		}
+		values[strings.TrimSpace(key)] = value
+	}
+
+	return frameGenerateOptions{
+		BaseDir:      baseDir,
+		Values:       values,
+		FillDefaults: flags.fillDefaults,
+		Locale:       flags.locale,
+	}, nil
+}
+
+func loadEnvFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read env file: %v", err)
+	}
+	defer file.Close()
+
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimPrefix(line, "export ")
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid line %v in env file %s, it must be KEY=VALUE", lineNumber, path)
+		}
+
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		values[strings.TrimSpace(key)] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read env file: %v", err)
+	}
+	return values, nil
+}
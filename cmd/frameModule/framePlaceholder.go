@@ -0,0 +1,122 @@
+package frameModule
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var placeholderPattern = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_.-]*)\}`)
+
+type placeholderResolver struct {
+	values     map[string]string
+	unresolved map[string]bool
+}
+
+func (resolver *placeholderResolver) resolve(value string) string {
+	return placeholderPattern.ReplaceAllStringFunc(value, func(match string) string {
+		if strings.HasPrefix(match, "$$") {
+			return match[1:]
+		}
+
+		name := placeholderPattern.FindStringSubmatch(match)[1]
+		resolved, found := resolver.values[name]
+		if !found {
+			resolver.unresolved[name] = true
+			return match
+		}
+		return resolved
+	})
+}
+
+func escapeFramePlaceholders(frame FrameDSLModel) FrameDSLModel {
+	return mapFrameStringValues(frame, func(value string) string {
+		return placeholderPattern.ReplaceAllStringFunc(value, func(match string) string {
+			return "$" + match
+		})
+	})
+}
+
+func substituteFramePlaceholders(frame FrameDSLModel, values map[string]string) (FrameDSLModel, error) {
+	resolver := &placeholderResolver{
+		values:     values,
+		unresolved: make(map[string]bool),
+	}
+
+	if frame.Variables != nil {
+		variables := make([]VariableDSLModel, len(frame.Variables))
+		for i, variable := range frame.Variables {
+			variable.Value = resolver.resolve(variable.Value)
+			variables[i] = variable
+		}
+		frame.Variables = variables
+	}
+	frame.Blocks = substituteBlockPlaceholders(frame.Blocks, resolver)
+
+	if len(resolver.unresolved) > 0 {
+		var names []string
+		for name := range resolver.unresolved {
+			names = append(names, "${"+name+"}")
+		}
+		sort.Strings(names)
+		return FrameDSLModel{}, fmt.Errorf("unresolved placeholders found: %s, please provide them with --env-file or --set", strings.Join(names, ","))
+	}
+	return frame, nil
+}
+
+func substituteBlockPlaceholders(blocks []BlockDSLModel, resolver *placeholderResolver) []BlockDSLModel {
+	if blocks == nil {
+		return nil
+	}
+
+	substituted := make([]BlockDSLModel, len(blocks))
+	for i, block := range blocks {
+		if block.Properties != nil {
+			properties := make([]BlockPropertyDSLModel, len(block.Properties))
+			for j, property := range block.Properties {
+				property.Value = resolver.resolve(property.Value)
+				property.ValueMobile = resolver.resolve(property.ValueMobile)
+				property.ValueTablet = resolver.resolve(property.ValueTablet)
+				property.ValueDesktop = resolver.resolve(property.ValueDesktop)
+				properties[j] = property
+			}
+			block.Properties = properties
+		}
+
+		if block.Actions != nil {
+			actions := make([]ActionDSLModel, len(block.Actions))
+			for j, action := range block.Actions {
+				action.Triggers = substituteTriggerPlaceholders(action.Triggers, resolver)
+				actions[j] = action
+			}
+			block.Actions = actions
+		}
+
+		block.Blocks = substituteBlockPlaceholders(block.Blocks, resolver)
+		substituted[i] = block
+	}
+	return substituted
+}
+
+func substituteTriggerPlaceholders(triggers []ActionTriggerDSLModel, resolver *placeholderResolver) []ActionTriggerDSLModel {
+	if triggers == nil {
+		return nil
+	}
+
+	substituted := make([]ActionTriggerDSLModel, len(triggers))
+	for i, trigger := range triggers {
+		if trigger.Properties != nil {
+			properties := make([]TriggerPropertyDSLModel, len(trigger.Properties))
+			for j, property := range trigger.Properties {
+				property.Value = resolver.resolve(property.Value)
+				properties[j] = property
+			}
+			trigger.Properties = properties
+		}
+
+		trigger.Triggers = substituteTriggerPlaceholders(trigger.Triggers, resolver)
+		substituted[i] = trigger
+	}
+	return substituted
+}
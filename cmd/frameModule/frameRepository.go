@@ -79,19 +79,106 @@ const getFrameQuery = `
   }
 `
 
-func pushFrame(output FrameProductionDataWrapper, regionUrl string, accessToken string, apiKey string) error {
+const getFrameWithVersionsQuery = `
+  query frame($route: String!) {
+    frame(route: $route) {
+      id
+      name
+      route
+      isStarter
+      type
+      variables {
+        key
+        value
+        type
+      }
+      blocks {
+        id
+        parentId
+        slot
+        keyType
+        key
+        visibilityKey
+        integrationVersion
+        position
+        properties {
+          key
+          valueDesktop
+          valueMobile
+          valueTablet
+          type
+        }
+        data {
+          key
+          value
+          type
+        }
+        slots {
+          slot
+        }
+      }
+      actions {
+        key
+        event
+        triggers {
+          id
+          parentId
+          keyType
+          then
+          name
+          integrationVersion
+          properties {
+            key
+            value
+            type
+          }
+          data {
+            key
+            value
+            type
+          }
+        }
+      }
+    }
+  }
+`
 
+const getFramesQuery = `
+  query frames {
+    frames {
+      id
+      name
+      route
+      type
+      isStarter
+    }
+  }
+`
+
+func buildSyncFrameInput(output FrameProductionDataWrapper) (map[string]interface{}, error) {
 	if output.Data.FrameProduction.Id == "" {
-		return errors.New("could not generate frame, please check your input")
+		return nil, errors.New("could not generate frame, please check your input")
 	}
 
-	client := graphqlutil.NewClient()
+	jsonBytes, err := json.Marshal(output.Data.FrameProduction)
+	if err != nil {
+		return nil, err
+	}
 
-	jsonBytes, _ := json.Marshal(output.Data.FrameProduction)
 	input := map[string]interface{}{
 		"route":     output.Data.FrameProduction.Route,
 		"frameJson": string(jsonBytes),
 	}
+	return input, nil
+}
+
+func pushFrame(output FrameProductionDataWrapper, regionUrl string, accessToken string, apiKey string) error {
+	input, err := buildSyncFrameInput(output)
+	if err != nil {
+		return err
+	}
+
+	client := graphqlutil.NewClient()
 
 	variables := map[string]interface{}{
 		"input": input,
@@ -102,7 +189,7 @@ func pushFrame(output FrameProductionDataWrapper, regionUrl string, accessToken
 		"Api-Key":       "Bearer " + apiKey,
 	}
 
-	_, err := client.Execute(
+	_, err = client.Execute(
 		regionUrl,
 		headers,
 		syncFrameMutation,
@@ -116,6 +203,32 @@ func pushFrame(output FrameProductionDataWrapper, regionUrl string, accessToken
 }
 
 func pullFrame(fm fileutil.FileManager, regionUrl string, accessToken string, apiKey string, fileName string, schema string, route string) error {
+	remoteFrame, err := getFrame(regionUrl, accessToken, apiKey, route)
+	if err != nil {
+		return err
+	}
+
+	frame := mapFrameModelToDSL(remoteFrame, schema)
+	if frame.Route == "" {
+		return fmt.Errorf("could not find frame route %v", frame.Route)
+	}
+	content, err := marshalFrameFile(fileName, frame)
+	if err != nil {
+		return err
+	}
+	if err := fm.SaveByteToFile(fileName, content); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func getFrame(regionUrl string, accessToken string, apiKey string, route string) (FrameModel, error) {
+	frame, _, err := getFrameWithVersions(regionUrl, accessToken, apiKey, route)
+	return frame, err
+}
+
+func getFrameWithVersions(regionUrl string, accessToken string, apiKey string, route string) (FrameModel, bool, error) {
 	client := graphqlutil.NewClient()
 
 	variables := map[string]interface{}{
@@ -127,29 +240,58 @@ func pullFrame(fm fileutil.FileManager, regionUrl string, accessToken string, ap
 		"Api-Key":       "Bearer " + apiKey,
 	}
 
+	hasVersions := true
 	apiResponse, err := client.Execute(
 		regionUrl,
 		headers,
-		getFrameQuery,
+		getFrameWithVersionsQuery,
 		variables,
 	)
+	if graphqlutil.IsValidationError(err) {
+		hasVersions = false
+		apiResponse, err = client.Execute(
+			regionUrl,
+			headers,
+			getFrameQuery,
+			variables,
+		)
+	}
 	if err != nil {
-		return fmt.Errorf("sync failed: %v", err)
+		return FrameModel{}, false, fmt.Errorf("sync failed: %v", err)
 	}
 
 	var frameResponse FrameWrapper
 	err = graphqlutil.Parse(apiResponse, &frameResponse)
 	if err != nil {
-		return err
+		return FrameModel{}, false, err
 	}
 
-	frame := mapFrameModelToDSL(frameResponse.Frame, schema)
-	if frame.Route == "" {
-		return fmt.Errorf("could not find frame route %v", frame.Route)
+	return frameResponse.Frame, hasVersions, nil
+}
+
+func getFrames(regionUrl string, accessToken string, apiKey string) ([]FrameModel, error) {
+	client := graphqlutil.NewClient()
+
+	headers := map[string]string{
+		"Authorization": "Bearer " + accessToken,
+		"Api-Key":       "Bearer " + apiKey,
 	}
-	if err := fm.SaveToFile(fileName, frame); err != nil {
-		return err
+
+	apiResponse, err := client.Execute(
+		regionUrl,
+		headers,
+		getFramesQuery,
+		map[string]interface{}{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch frames: %v", err)
 	}
 
-	return nil
+	var framesResponse FramesWrapper
+	err = graphqlutil.Parse(apiResponse, &framesResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return framesResponse.Frames, nil
 }
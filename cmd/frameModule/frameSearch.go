@@ -0,0 +1,338 @@
+package frameModule
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type frameSearchFilter struct {
+	KeyType  string
+	Property string
+	Variable string
+	Event    string
+	Trigger  string
+}
+
+type frameSearchResult struct {
+	File       string `json:"file"`
+	IncludedBy string `json:"includedBy,omitempty"`
+	Route      string `json:"route"`
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+	Line       int    `json:"line"`
+	KeyType    string `json:"keyType"`
+	Key        string `json:"key"`
+}
+
+func (filter frameSearchFilter) isEmpty() bool {
+	return filter == frameSearchFilter{}
+}
+
+func searchFrameFile(file string, filter frameSearchFilter) ([]frameSearchResult, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %v", err)
+	}
+
+	var frame FrameDSLModel
+	var lines map[string]int
+	if isCompactFrameFile(file) {
+		frame, lines, err = parseCompactFrame(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", file, err)
+		}
+	} else {
+		if err := json.Unmarshal(content, &frame); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", file, err)
+		}
+
+		lines, err = indexJSONLines(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", file, err)
+		}
+	}
+
+	searcher := &frameSearcher{
+		file:      file,
+		frameFile: file,
+		frame:     frame,
+		filter:    filter,
+		lines:     lines,
+	}
+	searcher.searchBlocks(frame.Blocks, "", "")
+	return searcher.results, searcher.err
+}
+
+func indexJSONLines(content []byte) (map[string]int, error) {
+	offsets, err := indexJSONOffsets(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var newlines []int
+	for index, char := range content {
+		if char == '\n' {
+			newlines = append(newlines, index)
+		}
+	}
+	lines := make(map[string]int)
+	for pointer, offset := range offsets {
+		lines[pointer] = sort.SearchInts(newlines, int(offset)) + 1
+	}
+	return lines, nil
+}
+
+type frameSearcher struct {
+	file      string
+	frameFile string
+	frame     FrameDSLModel
+	filter    frameSearchFilter
+	lines     map[string]int
+	prefix    string
+	stack     []string
+	results   []frameSearchResult
+	err       error
+}
+
+func (searcher *frameSearcher) addResult(path string, pointer string, keyType string, key string) {
+	includedBy := ""
+	if searcher.file != searcher.frameFile {
+		includedBy = searcher.frameFile
+	}
+	searcher.results = append(searcher.results, frameSearchResult{
+		File:       searcher.file,
+		IncludedBy: includedBy,
+		Route:      searcher.frame.Route,
+		Name:       searcher.frame.Name,
+		Path:       path,
+		Line:       searcher.lines[pointer],
+		KeyType:    keyType,
+		Key:        key,
+	})
+}
+
+func (searcher *frameSearcher) searchBlocks(blocks []BlockDSLModel, parentPath string, parentPointer string) {
+	for index, block := range blocks {
+		searcher.searchBlock(block, parentPath, index, parentPointer+"/blocks/"+strconv.Itoa(index))
+	}
+}
+
+func (searcher *frameSearcher) searchBlock(block BlockDSLModel, parentPath string, index int, pointer string) {
+	if block.Include != "" {
+		searcher.searchInclude(block, parentPath, index)
+		return
+	}
+
+	key := searcher.prefix + block.Key
+	path := fmt.Sprintf("%sblocks[%d](%s)", parentPath, index, key)
+	if searcher.matchBlock(block) {
+		if searcher.filter.Trigger == "" {
+			searcher.addResult(path, pointer, block.KeyType, key)
+		} else {
+			for actionIndex, action := range block.Actions {
+				actionPath := fmt.Sprintf("%s.actions[%d](%s).", path, actionIndex, action.Event)
+				actionPointer := pointer + "/actions/" + strconv.Itoa(actionIndex)
+				searcher.searchTriggers(action.Triggers, actionPath, actionPointer)
+			}
+		}
+	}
+
+	searcher.searchBlocks(block.Blocks, path+".", pointer)
+}
+
+func (searcher *frameSearcher) searchInclude(include BlockDSLModel, parentPath string, index int) {
+	if searcher.err != nil {
+		return
+	}
+
+	fragmentPath, err := resolveIncludePath(include.Include, filepath.Dir(searcher.file))
+	if err != nil {
+		searcher.err = err
+		return
+	}
+	for _, path := range searcher.stack {
+		if path == fragmentPath {
+			searcher.err = fmt.Errorf("include cycle found: %s -> %s", strings.Join(searcher.stack, " -> "), fragmentPath)
+			return
+		}
+	}
+
+	content, err := os.ReadFile(fragmentPath)
+	if err != nil {
+		searcher.err = fmt.Errorf("could not read the include %s: %v", include.Include, err)
+		return
+	}
+	substituted, _ := substituteIncludeParams(string(content), include.Params)
+
+	var fragment BlockDSLModel
+	var lines map[string]int
+	if isCompactFrameFile(fragmentPath) {
+		fragment, lines, err = parseCompactBlockLines([]byte(substituted))
+	} else if err = json.Unmarshal([]byte(substituted), &fragment); err == nil {
+		lines, err = indexJSONLines([]byte(substituted))
+	}
+	if err != nil {
+		searcher.err = fmt.Errorf("failed to parse the include %s: %v", include.Include, err)
+		return
+	}
+
+	fragmentSearcher := &frameSearcher{
+		file:      fragmentPath,
+		frameFile: searcher.frameFile,
+		frame:     searcher.frame,
+		filter:    searcher.filter,
+		lines:     lines,
+		prefix:    searcher.prefix + include.Prefix,
+		stack:     append(append([]string{}, searcher.stack...), fragmentPath),
+	}
+	fragmentSearcher.searchBlock(fragment, parentPath, index, "")
+	searcher.results = append(searcher.results, fragmentSearcher.results...)
+	searcher.err = fragmentSearcher.err
+}
+
+func (searcher *frameSearcher) searchTriggers(triggers []ActionTriggerDSLModel, parentPath string, parentPointer string) {
+	for index, trigger := range triggers {
+		path := fmt.Sprintf("%striggers[%d](%s)", parentPath, index, trigger.Name)
+		pointer := parentPointer + "/triggers/" + strconv.Itoa(index)
+
+		if trigger.KeyType == searcher.filter.Trigger || trigger.Name == searcher.filter.Trigger {
+			searcher.addResult(path, pointer, trigger.KeyType, trigger.Name)
+		}
+
+		searcher.searchTriggers(trigger.Triggers, path+".", pointer)
+	}
+}
+
+func (searcher *frameSearcher) matchBlock(block BlockDSLModel) bool {
+	filter := searcher.filter
+
+	if filter.KeyType != "" && block.KeyType != filter.KeyType {
+		return false
+	}
+
+	if filter.Property != "" && !containsBlockProperty(block.Properties, filter.Property) {
+		return false
+	}
+
+	if filter.Event != "" && findActionDSL(block, filter.Event) == nil {
+		return false
+	}
+
+	if filter.Variable != "" && !blockUsesVariable(block, filter.Variable) {
+		return false
+	}
+
+	if filter.Trigger != "" {
+		found := false
+		for _, action := range block.Actions {
+			if containsTrigger(action.Triggers, filter.Trigger) {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func blockUsesVariable(block BlockDSLModel, variable string) bool {
+	if block.VisibilityKey == variable {
+		return true
+	}
+	for _, dataItem := range block.Data {
+		if dataItem.Value == variable {
+			return true
+		}
+	}
+	for _, action := range block.Actions {
+		if triggersUseVariable(action.Triggers, variable) {
+			return true
+		}
+	}
+	return false
+}
+
+func triggersUseVariable(triggers []ActionTriggerDSLModel, variable string) bool {
+	for _, trigger := range triggers {
+		for _, dataItem := range trigger.Data {
+			if dataItem.Value == variable {
+				return true
+			}
+		}
+		if triggersUseVariable(trigger.Triggers, variable) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsTrigger(triggers []ActionTriggerDSLModel, trigger string) bool {
+	for _, item := range triggers {
+		if item.KeyType == trigger || item.Name == trigger {
+			return true
+		}
+		if containsTrigger(item.Triggers, trigger) {
+			return true
+		}
+	}
+	return false
+}
+
+func indexJSONOffsets(content []byte) (map[string]int64, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	offsets := make(map[string]int64)
+	if err := indexJSONValue(decoder, "", offsets); err != nil {
+		return nil, err
+	}
+	return offsets, nil
+}
+
+func indexJSONValue(decoder *json.Decoder, pointer string, offsets map[string]int64) error {
+	token, err := decoder.Token()
+	if err != nil {
+		if err == io.EOF {
+			return fmt.Errorf("unexpected end of json")
+		}
+		return err
+	}
+
+	delimiter, isDelimiter := token.(json.Delim)
+	if !isDelimiter {
+		return nil
+	}
+	offsets[pointer] = decoder.InputOffset() - 1
+
+	switch delimiter {
+	case '{':
+		for decoder.More() {
+			keyToken, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+			key, _ := keyToken.(string)
+			if err := indexJSONValue(decoder, pointer+"/"+key, offsets); err != nil {
+				return err
+			}
+		}
+	case '[':
+		index := 0
+		for decoder.More() {
+			if err := indexJSONValue(decoder, pointer+"/"+strconv.Itoa(index), offsets); err != nil {
+				return err
+			}
+			index++
+		}
+	}
+
+	_, err = decoder.Token()
+	return err
+}
@@ -0,0 +1,209 @@
+package frameModule
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nativeblocks/cli/library/fileutil"
+	"github.com/nativeblocks/cli/library/graphqlutil"
+)
+
+var queryFieldPattern = regexp.MustCompile(`\{\s*(frameProduction|frames|frame)\b`)
+
+var queryRoutePattern = regexp.MustCompile(`route\s*:\s*"([^"]*)"`)
+
+type frameServer struct {
+	mutex   sync.RWMutex
+	frames  map[string]FrameModel
+	options frameGenerateOptions
+}
+
+func newFrameServer(options frameGenerateOptions) *frameServer {
+	return &frameServer{
+		frames:  make(map[string]FrameModel),
+		options: options,
+	}
+}
+
+func (server *frameServer) reload(files []string) {
+	for _, file := range files {
+		timestamp := time.Now().Format("15:04:05")
+
+		if _, err := os.Stat(file); os.IsNotExist(err) {
+			server.mutex.Lock()
+			delete(server.frames, file)
+			server.mutex.Unlock()
+			fmt.Printf("[%s] %s: removed\n", timestamp, file)
+			continue
+		}
+
+		jsonDSL, err := loadFrameDSL(file)
+		if err != nil {
+			fmt.Printf("[%s] %s: %v\n", timestamp, file, err)
+			continue
+		}
+
+		options := server.options
+		options.BaseDir = fileutil.GetFileDir(file)
+		output, err := generateFrame(jsonDSL, options)
+		if err != nil {
+			fmt.Printf("[%s] %s: %v\n", timestamp, file, err)
+			continue
+		}
+		if output.Data.FrameProduction.Id == "" {
+			fmt.Printf("[%s] %s: invalid frame, keeping the previous version\n", timestamp, file)
+			continue
+		}
+
+		server.mutex.Lock()
+		server.frames[file] = output.Data.FrameProduction
+		server.mutex.Unlock()
+		fmt.Printf("[%s] %s: loaded %s\n", timestamp, file, output.Data.FrameProduction.Route)
+	}
+}
+
+func (server *frameServer) findFrame(route string) (FrameModel, bool) {
+	server.mutex.RLock()
+	defer server.mutex.RUnlock()
+
+	files := make([]string, 0, len(server.frames))
+	for file := range server.frames {
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		first, second := server.frames[files[i]].Route, server.frames[files[j]].Route
+		if routeSpecificity(first) != routeSpecificity(second) {
+			return routeSpecificity(first) > routeSpecificity(second)
+		}
+		if first != second {
+			return first < second
+		}
+		return files[i] < files[j]
+	})
+
+	for _, file := range files {
+		if server.frames[file].Route == route {
+			return server.frames[file], true
+		}
+	}
+	for _, file := range files {
+		if matchRoute(server.frames[file].Route, route) {
+			return server.frames[file], true
+		}
+	}
+	return FrameModel{}, false
+}
+
+func (server *frameServer) allFrames() []FrameModel {
+	server.mutex.RLock()
+	defer server.mutex.RUnlock()
+
+	frames := make([]FrameModel, 0, len(server.frames))
+	for _, frame := range server.frames {
+		frames = append(frames, frame)
+	}
+	sort.Slice(frames, func(i, j int) bool {
+		return frames[i].Route < frames[j].Route
+	})
+	return frames
+}
+
+func (server *frameServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "only POST graphql requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var graphQLRequest graphqlutil.GraphQLRequest
+	if err := json.NewDecoder(request.Body).Decode(&graphQLRequest); err != nil {
+		http.Error(writer, fmt.Sprintf("failed to parse request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	field := findQueryField(graphQLRequest.Query)
+	response := map[string]interface{}{}
+	status := http.StatusOK
+
+	switch field {
+	case "frames":
+		response["data"] = map[string]interface{}{"frames": server.allFrames()}
+	case "frame", "frameProduction":
+		route := findQueryRoute(graphQLRequest)
+		frame, found := server.findFrame(route)
+		if found {
+			response["data"] = map[string]interface{}{field: frame}
+		} else {
+			response["data"] = map[string]interface{}{field: nil}
+			response["errors"] = []map[string]string{{"message": "could not find frame route " + route}}
+		}
+		field = field + " " + route
+	default:
+		status = http.StatusBadRequest
+		response["errors"] = []map[string]string{{"message": "unsupported query, only frame, frameProduction and frames are served"}}
+	}
+
+	fmt.Printf("[%s] query %s\n", time.Now().Format("15:04:05"), field)
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_ = json.NewEncoder(writer).Encode(response)
+}
+
+func findQueryField(query string) string {
+	match := queryFieldPattern.FindStringSubmatch(query)
+	if len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+func findQueryRoute(request graphqlutil.GraphQLRequest) string {
+	if route, ok := request.Variables["route"].(string); ok {
+		return route
+	}
+
+	match := queryRoutePattern.FindStringSubmatch(request.Query)
+	if len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
+
+func matchRoute(pattern string, route string) bool {
+	patternSegments := strings.Split(strings.Trim(normalizeRoute(pattern), "/"), "/")
+	routeSegments := strings.Split(strings.Trim(normalizeRoute(route), "/"), "/")
+	if len(patternSegments) != len(routeSegments) {
+		return false
+	}
+
+	for i, segment := range patternSegments {
+		if isRouteArgumentSegment(segment) || isRouteArgumentSegment(routeSegments[i]) {
+			continue
+		}
+		if segment != routeSegments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func isRouteArgumentSegment(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+func routeSpecificity(route string) int {
+	specificity := 0
+	for _, segment := range strings.Split(strings.Trim(normalizeRoute(route), "/"), "/") {
+		if !isRouteArgumentSegment(segment) {
+			specificity++
+		}
+	}
+	return specificity
+}
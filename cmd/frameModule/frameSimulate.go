@@ -0,0 +1,128 @@
+package frameModule
+
+import (
+	"fmt"
+	"strings"
+)
+
+type frameSimulationStep struct {
+	Depth   string
+	Trigger ActionTriggerDSLModel
+	Outcome string
+}
+
+type frameSimulator struct {
+	failures map[string]bool
+	steps    []frameSimulationStep
+	issues   []frameLintIssue
+}
+
+func findBlockDSL(blocks []BlockDSLModel, key string) *BlockDSLModel {
+	for i := range blocks {
+		if blocks[i].Key == key {
+			return &blocks[i]
+		}
+		if found := findBlockDSL(blocks[i].Blocks, key); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func findActionDSL(block BlockDSLModel, event string) *ActionDSLModel {
+	for i := range block.Actions {
+		if block.Actions[i].Event == event {
+			return &block.Actions[i]
+		}
+	}
+	return nil
+}
+
+func simulateAction(action ActionDSLModel, failures []string) ([]frameSimulationStep, []frameLintIssue, error) {
+	simulator := &frameSimulator{failures: make(map[string]bool)}
+
+	names := make(map[string]bool)
+	collectTriggerNames(action.Triggers, names)
+	for _, name := range failures {
+		if !names[name] {
+			return nil, nil, fmt.Errorf("could not find trigger %v in the %v event", name, action.Event)
+		}
+		simulator.failures[name] = true
+	}
+
+	simulator.inspect(action.Triggers, fmt.Sprintf("actions(%s).", action.Event), true)
+	simulator.run(action.Triggers, "", "")
+	return simulator.steps, simulator.issues, nil
+}
+
+func collectTriggerNames(triggers []ActionTriggerDSLModel, names map[string]bool) {
+	for _, trigger := range triggers {
+		names[trigger.Name] = true
+		collectTriggerNames(trigger.Triggers, names)
+	}
+}
+
+func (simulator *frameSimulator) addWarning(path string, format string, args ...interface{}) {
+	simulator.issues = append(simulator.issues, frameLintIssue{Severity: lintSeverityWarning, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (simulator *frameSimulator) inspect(triggers []ActionTriggerDSLModel, parentPath string, isRoot bool) {
+	endTrigger := ""
+	hasSuccess := false
+	hasFailure := false
+	for index, trigger := range triggers {
+		path := fmt.Sprintf("%striggers[%d](%s)", parentPath, index, trigger.Name)
+
+		if endTrigger != "" {
+			simulator.addWarning(path, "trigger %s is unreachable, the flow ends at %s", trigger.Name, endTrigger)
+			continue
+		}
+		if isRoot && (trigger.Then == "SUCCESS" || trigger.Then == "FAILURE") {
+			simulator.addWarning(path, "trigger %s is unreachable, %s needs a parent trigger", trigger.Name, trigger.Then)
+			continue
+		}
+
+		switch trigger.Then {
+		case "END":
+			if endTrigger == "" {
+				endTrigger = trigger.Name
+			}
+		case "SUCCESS":
+			hasSuccess = true
+		case "FAILURE":
+			hasFailure = true
+		}
+
+		if len(trigger.Triggers) == 0 && trigger.Then == "NEXT" && index == len(triggers)-1 {
+			simulator.addWarning(path, "trigger %s is a dead end, the flow stops without an END trigger", trigger.Name)
+		}
+
+		simulator.inspect(trigger.Triggers, path+".", false)
+	}
+
+	if !isRoot && hasSuccess && !hasFailure {
+		simulator.addWarning(strings.TrimSuffix(parentPath, "."), "missing FAILURE branch next to the SUCCESS branch")
+	}
+}
+
+func (simulator *frameSimulator) run(triggers []ActionTriggerDSLModel, parentOutcome string, depth string) bool {
+	for _, trigger := range triggers {
+		if trigger.Then != "NEXT" && trigger.Then != "END" && trigger.Then != parentOutcome {
+			continue
+		}
+
+		outcome := "SUCCESS"
+		if simulator.failures[trigger.Name] {
+			outcome = "FAILURE"
+		}
+		simulator.steps = append(simulator.steps, frameSimulationStep{Depth: depth, Trigger: trigger, Outcome: outcome})
+
+		if trigger.Then == "END" {
+			return true
+		}
+		if simulator.run(trigger.Triggers, outcome, depth+"  ") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package frameModule
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type frameStats struct {
+	Blocks      int
+	MaxDepth    int
+	Actions     int
+	Triggers    int
+	Variables   int
+	PayloadSize int
+	KeyTypes    map[string]int
+}
+
+type frameStatsLimits struct {
+	MaxBlocks      int `json:"maxBlocks"`
+	MaxDepth       int `json:"maxDepth"`
+	MaxActions     int `json:"maxActions"`
+	MaxTriggers    int `json:"maxTriggers"`
+	MaxVariables   int `json:"maxVariables"`
+	MaxPayloadSize int `json:"maxPayloadSize"`
+}
+
+func collectFrameStats(frame FrameModel) (frameStats, error) {
+	payload, err := json.Marshal(FrameProductionDataWrapper{Data: FrameProductionWrapper{FrameProduction: frame}})
+	if err != nil {
+		return frameStats{}, err
+	}
+
+	stats := frameStats{
+		Blocks:      len(frame.Blocks),
+		Actions:     len(frame.Actions),
+		Variables:   len(frame.Variables),
+		PayloadSize: len(payload),
+		KeyTypes:    make(map[string]int),
+	}
+
+	parents := make(map[string]string)
+	for _, block := range frame.Blocks {
+		parents[block.Id] = block.ParentId
+		stats.KeyTypes[block.KeyType]++
+	}
+	for _, block := range frame.Blocks {
+		depth := 1
+		for parentId, visited := parents[block.Id], 0; parentId != "" && visited < len(frame.Blocks); visited++ {
+			depth++
+			parentId = parents[parentId]
+		}
+		if depth > stats.MaxDepth {
+			stats.MaxDepth = depth
+		}
+	}
+
+	for _, action := range frame.Actions {
+		stats.Triggers += len(action.Triggers)
+	}
+
+	return stats, nil
+}
+
+func (limits frameStatsLimits) merge(override frameStatsLimits) frameStatsLimits {
+	if override.MaxBlocks > 0 {
+		limits.MaxBlocks = override.MaxBlocks
+	}
+	if override.MaxDepth > 0 {
+		limits.MaxDepth = override.MaxDepth
+	}
+	if override.MaxActions > 0 {
+		limits.MaxActions = override.MaxActions
+	}
+	if override.MaxTriggers > 0 {
+		limits.MaxTriggers = override.MaxTriggers
+	}
+	if override.MaxVariables > 0 {
+		limits.MaxVariables = override.MaxVariables
+	}
+	if override.MaxPayloadSize > 0 {
+		limits.MaxPayloadSize = override.MaxPayloadSize
+	}
+	return limits
+}
+
+func (limits frameStatsLimits) check(stats frameStats) []string {
+	var exceeded []string
+	checks := []struct {
+		name  string
+		value int
+		limit int
+	}{
+		{"blocks", stats.Blocks, limits.MaxBlocks},
+		{"depth", stats.MaxDepth, limits.MaxDepth},
+		{"actions", stats.Actions, limits.MaxActions},
+		{"triggers", stats.Triggers, limits.MaxTriggers},
+		{"variables", stats.Variables, limits.MaxVariables},
+		{"payload size", stats.PayloadSize, limits.MaxPayloadSize},
+	}
+	for _, check := range checks {
+		if check.limit > 0 && check.value > check.limit {
+			exceeded = append(exceeded, fmt.Sprintf("%s %v exceeds the limit of %v", check.name, check.value, check.limit))
+		}
+	}
+	return exceeded
+}
+
+func renderFrameStats(stats frameStats) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("  blocks: %v\n", stats.Blocks))
+	builder.WriteString(fmt.Sprintf("  max depth: %v\n", stats.MaxDepth))
+	builder.WriteString(fmt.Sprintf("  actions: %v\n", stats.Actions))
+	builder.WriteString(fmt.Sprintf("  triggers: %v\n", stats.Triggers))
+	builder.WriteString(fmt.Sprintf("  variables: %v\n", stats.Variables))
+	builder.WriteString(fmt.Sprintf("  payload size: %v bytes\n", stats.PayloadSize))
+
+	keyTypes := make([]string, 0, len(stats.KeyTypes))
+	for keyType := range stats.KeyTypes {
+		keyTypes = append(keyTypes, keyType)
+	}
+	sort.Slice(keyTypes, func(i, j int) bool {
+		if stats.KeyTypes[keyTypes[i]] != stats.KeyTypes[keyTypes[j]] {
+			return stats.KeyTypes[keyTypes[i]] > stats.KeyTypes[keyTypes[j]]
+		}
+		return keyTypes[i] < keyTypes[j]
+	})
+
+	builder.WriteString("  keyTypes:\n")
+	for _, keyType := range keyTypes {
+		builder.WriteString(fmt.Sprintf("    %-24s %v\n", keyType, stats.KeyTypes[keyType]))
+	}
+	return builder.String()
+}
@@ -0,0 +1,148 @@
+package frameModule
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+)
+
+const frameTemplateExtension = ".tmpl"
+
+const defaultFrameTemplate = `{
+  "$schema": {{json .Schema}},
+  "name": {{json .Name}},
+  "route": {{json .Route}},
+  "type": {{json .Type}},
+  "isStarter": {{.IsStarter}},
+  "variables": [
+    {{- range $index, $argument := .RouteArguments}}{{if $index}},{{end}}
+    {
+      "key": {{json $argument.Name}},
+      "value": {{json (zeroValue $argument.Type)}},
+      "type": {{json $argument.Type}}
+    }
+    {{- end}}
+  ],
+  "blocks": [
+    {
+      "keyType": "ROOT",
+      "key": "root",
+      "visibilityKey": "",
+      "slot": "null",
+      "integrationVersion": 0,
+      "data": [],
+      "properties": [],
+      "slots": [],
+      "actions": [],
+      "blocks": []
+    }
+  ]
+}
+`
+
+const emptyFrameTemplate = `{
+  "$schema": {{json .Schema}},
+  "name": {{json .Name}},
+  "route": {{json .Route}},
+  "type": {{json .Type}},
+  "isStarter": {{.IsStarter}},
+  "variables": [],
+  "blocks": []
+}
+`
+
+var builtInFrameTemplates = map[string]string{
+	"default": defaultFrameTemplate,
+	"empty":   emptyFrameTemplate,
+}
+
+type frameTemplateData struct {
+	Schema         string
+	Name           string
+	Route          string
+	Type           string
+	IsStarter      bool
+	RouteArguments []RouteArgument
+}
+
+var frameTemplateFuncs = template.FuncMap{
+	"json": func(value interface{}) (string, error) {
+		jsonBytes, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		return string(jsonBytes), nil
+	},
+	"camel":     strcase.ToLowerCamel,
+	"pascal":    strcase.ToCamel,
+	"snake":     strcase.ToSnake,
+	"kebab":     strcase.ToKebab,
+	"routeSlug": routeSlug,
+	"zeroValue": zeroValue,
+}
+
+func loadFrameTemplate(name string, templatesDir string) (string, error) {
+	if templatesDir != "" {
+		templatePath := filepath.Join(templatesDir, name+frameTemplateExtension)
+		content, err := os.ReadFile(templatePath)
+		if err == nil {
+			return string(content), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", fmt.Errorf("failed to read template %s: %v", templatePath, err)
+		}
+	}
+
+	content, ok := builtInFrameTemplates[name]
+	if !ok {
+		return "", fmt.Errorf("could not find the frame template: %s", name)
+	}
+	return content, nil
+}
+
+func generateFrameFromTemplate(name string, templatesDir string, data frameTemplateData) (FrameDSLModel, error) {
+	content, err := loadFrameTemplate(name, templatesDir)
+	if err != nil {
+		return FrameDSLModel{}, err
+	}
+
+	tmpl, err := template.New(name).Funcs(frameTemplateFuncs).Parse(content)
+	if err != nil {
+		return FrameDSLModel{}, fmt.Errorf("failed to parse template %s: %v", name, err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return FrameDSLModel{}, fmt.Errorf("failed to execute template %s: %v", name, err)
+	}
+
+	var frame FrameDSLModel
+	if err := json.Unmarshal(buffer.Bytes(), &frame); err != nil {
+		return FrameDSLModel{}, fmt.Errorf("template %s did not produce a valid frame: %v", name, err)
+	}
+
+	if frame.Variables == nil {
+		frame.Variables = []VariableDSLModel{}
+	}
+	if frame.Blocks == nil {
+		frame.Blocks = []BlockDSLModel{}
+	}
+	return frame, nil
+}
+
+func zeroValue(valueType string) string {
+	switch valueType {
+	case "INT", "LONG":
+		return "0"
+	case "DOUBLE", "FLOAT":
+		return "0.0"
+	case "BOOLEAN":
+		return "false"
+	}
+	return ""
+}
@@ -0,0 +1,109 @@
+package frameModule
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type frameTreeNode struct {
+	Label    string
+	Children []frameTreeNode
+}
+
+func buildFrameTree(frame FrameModel, showTriggers bool) frameTreeNode {
+	childBlocks := make(map[string][]BlockModel)
+	blockIds := make(map[string]bool)
+	for _, block := range frame.Blocks {
+		blockIds[block.Id] = true
+	}
+	for _, block := range frame.Blocks {
+		parentId := block.ParentId
+		if !blockIds[parentId] {
+			parentId = ""
+		}
+		childBlocks[parentId] = append(childBlocks[parentId], block)
+	}
+	for parentId := range childBlocks {
+		children := childBlocks[parentId]
+		sort.SliceStable(children, func(i, j int) bool {
+			return children[i].Position < children[j].Position
+		})
+	}
+
+	blockActions := make(map[string][]ActionModel)
+	for _, action := range frame.Actions {
+		blockActions[action.Key] = append(blockActions[action.Key], action)
+	}
+
+	root := frameTreeNode{Label: fmt.Sprintf("%s (%s, %s)", frame.Route, frame.Name, frame.Type)}
+	root.Children = buildBlockTreeNodes(childBlocks, blockActions, "", showTriggers)
+	return root
+}
+
+func buildBlockTreeNodes(childBlocks map[string][]BlockModel, blockActions map[string][]ActionModel, parentId string, showTriggers bool) []frameTreeNode {
+	var nodes []frameTreeNode
+	for _, block := range childBlocks[parentId] {
+		label := block.KeyType + " " + block.Key
+		if block.Slot != "" && block.Slot != "null" {
+			label += " slot=" + block.Slot
+		}
+		if block.VisibilityKey != "" {
+			label += " visibility=" + block.VisibilityKey
+		}
+
+		actions := blockActions[block.Key]
+		if len(actions) > 0 {
+			var events []string
+			for _, action := range actions {
+				events = append(events, action.Event)
+			}
+			label += " events=[" + strings.Join(events, ", ") + "]"
+		}
+
+		node := frameTreeNode{Label: label}
+		if showTriggers {
+			for _, action := range actions {
+				node.Children = append(node.Children, frameTreeNode{
+					Label:    "on " + action.Event,
+					Children: buildTriggerTreeNodes(action.Triggers, ""),
+				})
+			}
+		}
+		node.Children = append(node.Children, buildBlockTreeNodes(childBlocks, blockActions, block.Id, showTriggers)...)
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+func buildTriggerTreeNodes(triggers []ActionTriggerModel, parentId string) []frameTreeNode {
+	var nodes []frameTreeNode
+	for _, trigger := range triggers {
+		if trigger.ParentId != parentId {
+			continue
+		}
+		nodes = append(nodes, frameTreeNode{
+			Label:    fmt.Sprintf("%s %s then=%s", trigger.KeyType, trigger.Name, trigger.Then),
+			Children: buildTriggerTreeNodes(triggers, trigger.Id),
+		})
+	}
+	return nodes
+}
+
+func renderFrameTree(root frameTreeNode) string {
+	var builder strings.Builder
+	builder.WriteString(root.Label + "\n")
+	renderFrameTreeChildren(&builder, root.Children, "")
+	return builder.String()
+}
+
+func renderFrameTreeChildren(builder *strings.Builder, nodes []frameTreeNode, prefix string) {
+	for index, node := range nodes {
+		connector, childPrefix := "├── ", "│   "
+		if index == len(nodes)-1 {
+			connector, childPrefix = "└── ", "    "
+		}
+		builder.WriteString(prefix + connector + node.Label + "\n")
+		renderFrameTreeChildren(builder, node.Children, prefix+childPrefix)
+	}
+}
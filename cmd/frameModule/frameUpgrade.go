@@ -0,0 +1,164 @@
+package frameModule
+
+import (
+	"fmt"
+)
+
+type frameUpgradeChange struct {
+	Path    string
+	Message string
+}
+
+type frameUpgrader struct {
+	blocks  map[string]IntegrationSchemaModel
+	actions map[string]IntegrationSchemaModel
+	changes []frameUpgradeChange
+}
+
+func upgradeFrame(frame FrameDSLModel, blocks map[string]IntegrationSchemaModel, actions map[string]IntegrationSchemaModel) (FrameDSLModel, []frameUpgradeChange) {
+	upgrader := &frameUpgrader{
+		blocks:  blocks,
+		actions: actions,
+	}
+	frame.Blocks = upgrader.upgradeBlocks(frame.Blocks, "")
+	return frame, upgrader.changes
+}
+
+func upgradeBlockFragment(fragment BlockDSLModel, blocks map[string]IntegrationSchemaModel, actions map[string]IntegrationSchemaModel) (BlockDSLModel, []frameUpgradeChange) {
+	upgrader := &frameUpgrader{
+		blocks:  blocks,
+		actions: actions,
+	}
+	fragment = upgrader.upgradeBlocks([]BlockDSLModel{fragment}, "")[0]
+	return fragment, upgrader.changes
+}
+
+func (upgrader *frameUpgrader) addChange(path string, format string, args ...interface{}) {
+	upgrader.changes = append(upgrader.changes, frameUpgradeChange{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (upgrader *frameUpgrader) upgradeBlocks(blocks []BlockDSLModel, parentPath string) []BlockDSLModel {
+	upgraded := make([]BlockDSLModel, len(blocks))
+	for index, block := range blocks {
+		path := fmt.Sprintf("%sblocks[%d](%s)", parentPath, index, block.Key)
+		if block.Include != "" {
+			upgraded[index] = block
+			continue
+		}
+
+		integration, found := upgrader.blocks[block.KeyType]
+		if found && integration.Version > block.IntegrationVersion {
+			upgrader.addChange(path, "%s integrationVersion %v -> %v", block.KeyType, block.IntegrationVersion, integration.Version)
+			block.IntegrationVersion = integration.Version
+
+			var properties []BlockPropertyDSLModel
+			for _, property := range block.Properties {
+				if findIntegrationProperty(integration.Properties, property.Key) == nil {
+					upgrader.addChange(path, "removed property %s", property.Key)
+					continue
+				}
+				properties = append(properties, property)
+			}
+			for _, integrationProperty := range integration.Properties {
+				if integrationProperty.Deprecated || containsBlockProperty(properties, integrationProperty.Key) {
+					continue
+				}
+				upgrader.addChange(path, "added property %s with %q", integrationProperty.Key, integrationProperty.Value)
+				properties = append(properties, BlockPropertyDSLModel{
+					Key:          integrationProperty.Key,
+					ValueMobile:  integrationProperty.Value,
+					ValueTablet:  integrationProperty.Value,
+					ValueDesktop: integrationProperty.Value,
+					Type:         integrationProperty.Type,
+				})
+			}
+			block.Properties = properties
+		}
+
+		actions := make([]ActionDSLModel, len(block.Actions))
+		for actionIndex, action := range block.Actions {
+			actionPath := fmt.Sprintf("%s.actions[%d](%s).", path, actionIndex, action.Event)
+			action.Triggers = upgrader.upgradeTriggers(action.Triggers, actionPath)
+			actions[actionIndex] = action
+		}
+		if block.Actions != nil {
+			block.Actions = actions
+		}
+
+		block.Blocks = upgrader.upgradeBlocks(block.Blocks, path+".")
+		upgraded[index] = block
+	}
+	if blocks == nil {
+		return nil
+	}
+	return upgraded
+}
+
+func (upgrader *frameUpgrader) upgradeTriggers(triggers []ActionTriggerDSLModel, parentPath string) []ActionTriggerDSLModel {
+	if triggers == nil {
+		return nil
+	}
+
+	upgraded := make([]ActionTriggerDSLModel, len(triggers))
+	for index, trigger := range triggers {
+		path := fmt.Sprintf("%striggers[%d](%s)", parentPath, index, trigger.Name)
+
+		integration, found := upgrader.actions[trigger.KeyType]
+		if found && integration.Version > trigger.IntegrationVersion {
+			upgrader.addChange(path, "%s integrationVersion %v -> %v", trigger.KeyType, trigger.IntegrationVersion, integration.Version)
+			trigger.IntegrationVersion = integration.Version
+
+			var properties []TriggerPropertyDSLModel
+			for _, property := range trigger.Properties {
+				if findIntegrationProperty(integration.Properties, property.Key) == nil {
+					upgrader.addChange(path, "removed property %s", property.Key)
+					continue
+				}
+				properties = append(properties, property)
+			}
+			for _, integrationProperty := range integration.Properties {
+				if integrationProperty.Deprecated || containsTriggerProperty(properties, integrationProperty.Key) {
+					continue
+				}
+				upgrader.addChange(path, "added property %s with %q", integrationProperty.Key, integrationProperty.Value)
+				properties = append(properties, TriggerPropertyDSLModel{
+					Key:   integrationProperty.Key,
+					Value: integrationProperty.Value,
+					Type:  integrationProperty.Type,
+				})
+			}
+			trigger.Properties = properties
+		}
+
+		trigger.Triggers = upgrader.upgradeTriggers(trigger.Triggers, path+".")
+		upgraded[index] = trigger
+	}
+	return upgraded
+}
+
+func findIntegrationProperty(properties []IntegrationSchemaPropertyModel, key string) *IntegrationSchemaPropertyModel {
+	for i := range properties {
+		if properties[i].Key == key {
+			return &properties[i]
+		}
+	}
+	return nil
+}
+
+func containsBlockProperty(properties []BlockPropertyDSLModel, key string) bool {
+	for _, property := range properties {
+		if property.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func containsTriggerProperty(properties []TriggerPropertyDSLModel, key string) bool {
+	for _, property := range properties {
+		if property.Key == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package frameModule
+
+import (
+	"sort"
+	"time"
+)
+
+type frameWatcher struct {
+	path     string
+	modTimes map[string]time.Time
+}
+
+func newFrameWatcher(path string) (*frameWatcher, []string, error) {
+	watcher := &frameWatcher{
+		path:     path,
+		modTimes: make(map[string]time.Time),
+	}
+
+	files, err := watcher.scan()
+	if err != nil {
+		return nil, nil, err
+	}
+	return watcher, files, nil
+}
+
+func (watcher *frameWatcher) scan() ([]string, error) {
+	files, err := findFrameFiles(watcher.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	seen := make(map[string]bool)
+	for _, file := range files {
+		seen[file] = true
+
+		modTime, err := fileModTime(file)
+		if err != nil {
+			continue
+		}
+
+		if previous, exists := watcher.modTimes[file]; !exists || !previous.Equal(modTime) {
+			watcher.modTimes[file] = modTime
+			changed = append(changed, file)
+		}
+	}
+
+	for file, previous := range watcher.modTimes {
+		if seen[file] {
+			continue
+		}
+
+		modTime, err := fileModTime(file)
+		if err != nil {
+			delete(watcher.modTimes, file)
+			changed = append(changed, file)
+		} else if !previous.Equal(modTime) {
+			watcher.modTimes[file] = modTime
+			changed = append(changed, file)
+		}
+	}
+
+	return changed, nil
+}
+
+func (watcher *frameWatcher) watch(interval time.Duration, debounce time.Duration, onChange func(files []string), onError func(err error)) {
+	pending := make(map[string]bool)
+	var lastChange time.Time
+	lastError := ""
+
+	for {
+		time.Sleep(interval)
+
+		changed, err := watcher.scan()
+		if err != nil {
+			if err.Error() != lastError {
+				lastError = err.Error()
+				onError(err)
+			}
+			continue
+		}
+		lastError = ""
+
+		if len(changed) > 0 {
+			for _, file := range changed {
+				pending[file] = true
+			}
+			lastChange = time.Now()
+			continue
+		}
+
+		if len(pending) > 0 && time.Since(lastChange) >= debounce {
+			var files []string
+			for file := range pending {
+				files = append(files, file)
+			}
+			sort.Strings(files)
+			pending = make(map[string]bool)
+			onChange(files)
+		}
+	}
+}
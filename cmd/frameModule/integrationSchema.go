@@ -0,0 +1,238 @@
+package frameModule
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nativeblocks/cli/cmd/authModule"
+	"github.com/nativeblocks/cli/cmd/organizationModule"
+	"github.com/nativeblocks/cli/cmd/projectModule"
+	"github.com/nativeblocks/cli/cmd/regionModule"
+	"github.com/nativeblocks/cli/library/fileutil"
+	"github.com/nativeblocks/cli/library/jsonutil"
+)
+
+const (
+	blocksSchemaFileName  = "blocks.json"
+	actionsSchemaFileName = "actions.json"
+)
+
+type IntegrationSchemaModel struct {
+	KeyType          string                           `json:"keyType"`
+	Version          int                              `json:"version"`
+	Deprecated       bool                             `json:"deprecated"`
+	DeprecatedReason string                           `json:"deprecatedReason"`
+	Properties       []IntegrationSchemaPropertyModel `json:"properties"`
+	Data             []IntegrationSchemaDataModel     `json:"data"`
+	Events           []IntegrationSchemaEventModel    `json:"events"`
+	Slots            []IntegrationSchemaSlotModel     `json:"slots"`
+}
+
+type IntegrationSchemaPropertyModel struct {
+	Key              string `json:"key"`
+	Value            string `json:"value"`
+	Type             string `json:"type"`
+	Deprecated       bool   `json:"deprecated"`
+	DeprecatedReason string `json:"deprecatedReason"`
+}
+
+type IntegrationSchemaDataModel struct {
+	Key              string `json:"key"`
+	Type             string `json:"type"`
+	Deprecated       bool   `json:"deprecated"`
+	DeprecatedReason string `json:"deprecatedReason"`
+}
+
+type IntegrationSchemaEventModel struct {
+	Event            string `json:"event"`
+	Deprecated       bool   `json:"deprecated"`
+	DeprecatedReason string `json:"deprecatedReason"`
+}
+
+type IntegrationSchemaSlotModel struct {
+	Slot             string `json:"slot"`
+	Deprecated       bool   `json:"deprecated"`
+	DeprecatedReason string `json:"deprecatedReason"`
+}
+
+func loadIntegrationSchema(source string) (map[string]IntegrationSchemaModel, error) {
+	rawIntegrations := make(map[string]json.RawMessage)
+
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		if err := jsonutil.FetchJSONFromURL(source, &rawIntegrations); err != nil {
+			return nil, err
+		}
+	} else {
+		content, err := os.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read integration schema %s: %v", source, err)
+		}
+		if err := json.Unmarshal(content, &rawIntegrations); err != nil {
+			return nil, fmt.Errorf("failed to parse integration schema %s: %v", source, err)
+		}
+	}
+
+	integrations := make(map[string]IntegrationSchemaModel)
+	for key, value := range rawIntegrations {
+		if key == "schema-version" {
+			continue
+		}
+
+		var integration IntegrationSchemaModel
+		if err := json.Unmarshal(value, &integration); err != nil {
+			return nil, fmt.Errorf("failed to parse integration %s: %v", key, err)
+		}
+		if integration.KeyType == "" {
+			integration.KeyType = key
+		}
+		integrations[integration.KeyType] = integration
+	}
+	return integrations, nil
+}
+
+func resolveIntegrationSchemaSource(source string, frameDir string, schema string, fileName string) (string, error) {
+	if source != "" {
+		return source, nil
+	}
+
+	if schemaPath := localSchemaPath(schema, frameDir); schemaPath != "" {
+		path := filepath.Join(filepath.Dir(schemaPath), fileName)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	if projectDir := findProjectDir(frameDir); projectDir != "" {
+		path := filepath.Join(projectDir, fileutil.ConfigDirName, fileName)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("could not find %s next to the $schema file or in the project, please provide it or run 'nativeblocks project gen-schema' in the project", fileName)
+}
+
+func localSchemaPath(schema string, frameDir string) string {
+	if schema == "" || strings.HasPrefix(schema, "http://") || strings.HasPrefix(schema, "https://") {
+		return ""
+	}
+
+	path := strings.TrimPrefix(schema, "file://")
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(frameDir, path)
+	}
+	return path
+}
+
+func loadIntegrationSchemas(blocksSource string, actionsSource string, frameDir string, schema string) (map[string]IntegrationSchemaModel, map[string]IntegrationSchemaModel, error) {
+	blocksSource, err := resolveIntegrationSchemaSource(blocksSource, frameDir, schema, blocksSchemaFileName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actionsSource, err = resolveIntegrationSchemaSource(actionsSource, frameDir, schema, actionsSchemaFileName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blocks, err := loadIntegrationSchema(blocksSource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actions, err := loadIntegrationSchema(actionsSource)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blocks, actions, nil
+}
+
+func loadInstalledIntegrationSchemas(fm fileutil.FileManager) (map[string]IntegrationSchemaModel, map[string]IntegrationSchemaModel, error) {
+	region, err := regionModule.GetRegion(fm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	auth, err := authModule.AuthGet(fm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	organization, err := organizationModule.GetOrganization(fm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project, err := projectModule.GetProject(fm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getInstalledIntegrationSchemas(region.Url, auth.AccessToken, organization.Id, project.Id)
+}
+
+func getInstalledIntegrationSchemas(regionUrl string, accessToken string, organizationId string, projectId string) (map[string]IntegrationSchemaModel, map[string]IntegrationSchemaModel, error) {
+	installedBlocks, err := projectModule.GetInstalledIntegration(regionUrl, accessToken, organizationId, projectId, "BLOCK")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	installedActions, err := projectModule.GetInstalledIntegration(regionUrl, accessToken, organizationId, projectId, "ACTION")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mapInstalledIntegrationsToSchema(installedBlocks), mapInstalledIntegrationsToSchema(installedActions), nil
+}
+
+func mapInstalledIntegrationsToSchema(installedIntegrations []projectModule.IntegrationProjectModel) map[string]IntegrationSchemaModel {
+	integrations := make(map[string]IntegrationSchemaModel)
+	for _, installedIntegration := range installedIntegrations {
+		integration := IntegrationSchemaModel{
+			KeyType:          installedIntegration.IntegrationKeyType,
+			Version:          int(installedIntegration.IntegrationVersion),
+			Deprecated:       installedIntegration.IntegrationDeprecated,
+			DeprecatedReason: installedIntegration.IntegrationDeprecatedReason,
+		}
+
+		for _, property := range installedIntegration.IntegrationProperties {
+			integration.Properties = append(integration.Properties, IntegrationSchemaPropertyModel{
+				Key:              property.Key,
+				Value:            property.Value,
+				Type:             property.Type,
+				Deprecated:       property.Deprecated,
+				DeprecatedReason: property.DeprecatedReason,
+			})
+		}
+
+		for _, dataItem := range installedIntegration.IntegrationData {
+			integration.Data = append(integration.Data, IntegrationSchemaDataModel{
+				Key:              dataItem.Key,
+				Type:             dataItem.Type,
+				Deprecated:       dataItem.Deprecated,
+				DeprecatedReason: dataItem.DeprecatedReason,
+			})
+		}
+
+		for _, event := range installedIntegration.IntegrationEvents {
+			integration.Events = append(integration.Events, IntegrationSchemaEventModel{
+				Event:            event.Event,
+				Deprecated:       event.Deprecated,
+				DeprecatedReason: event.DeprecatedReason,
+			})
+		}
+
+		for _, slot := range installedIntegration.IntegrationSlots {
+			integration.Slots = append(integration.Slots, IntegrationSchemaSlotModel{
+				Slot:             slot.Slot,
+				Deprecated:       slot.Deprecated,
+				DeprecatedReason: slot.DeprecatedReason,
+			})
+		}
+
+		integrations[integration.KeyType] = integration
+	}
+	return integrations
+}
@@ -229,7 +229,7 @@ func mapFrameModelToDSL(frame FrameModel, schema string) FrameDSLModel {
 		variables[i] = mapVariableModelToDSL(variable)
 	}
 
-	return FrameDSLModel{
+	return escapeFramePlaceholders(FrameDSLModel{
 		Schema:    schema,
 		Name:      frame.Name,
 		Route:     frame.Route,
@@ -237,5 +237,5 @@ func mapFrameModelToDSL(frame FrameModel, schema string) FrameDSLModel {
 		IsStarter: frame.IsStarter,
 		Variables: variables,
 		Blocks:    buildBlockTreeWithActions(frame.Blocks, frame.Actions),
-	}
+	})
 }
@@ -7,6 +7,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
 )
 
 type Schema struct {
@@ -41,7 +42,15 @@ type Trigger struct {
 	Triggers           []Trigger        `json:"triggers"`
 }
 
-func generateBaseSchema(version string, blockKeyTypes, actionKeyTypes, blockProperties, blockData, blockSlots, blockEvents, actionProperties, actionData []string) (Schema, error) {
+type IntegrationDefinition struct {
+	KeyType    string                     `json:"keyType"`
+	Properties []IntegrationPropertyModel `json:"properties"`
+	Data       []IntegrationDataModel     `json:"data"`
+	Events     []IntegrationEventModel    `json:"events"`
+	Slots      []IntegrationSlotModel     `json:"slots"`
+}
+
+func generateBaseSchema(version string, blockKeyTypes, actionKeyTypes, blockProperties, blockData, blockSlots, blockEvents, actionProperties, actionData []string, blockDefinitions, actionDefinitions []IntegrationDefinition) (Schema, error) {
 	baseSchema := Schema{
 		Schema:        "http://json-schema.org/draft-07/schema#",
 		SchemaVersion: version,
@@ -149,12 +158,19 @@ func generateBaseSchema(version string, blockKeyTypes, actionKeyTypes, blockProp
 						"type": "array",
 						"items": map[string]interface{}{
 							"type":     "object",
-							"required": []string{"key", "valueMobile", "valueTablet", "valueDesktop", "type"},
+							"required": []string{"key", "type"},
+							"anyOf": []map[string]interface{}{
+								{"required": []string{"value"}},
+								{"required": []string{"valueMobile", "valueTablet", "valueDesktop"}},
+							},
 							"properties": map[string]interface{}{
 								"key": map[string]interface{}{
 									"type": "string",
 									"enum": getUniqueKeys(blockProperties),
 								},
+								"value": map[string]string{
+									"type": "string",
+								},
 								"valueMobile": map[string]string{
 									"type": "string",
 								},
@@ -267,9 +283,188 @@ func generateBaseSchema(version string, blockKeyTypes, actionKeyTypes, blockProp
 		},
 	}
 
+	definitions := baseSchema.Definitions.(map[string]interface{})
+	blockConditions := make([]interface{}, 0)
+	for _, integration := range blockDefinitions {
+		name := "block-" + integration.KeyType
+		definitions[name] = generateBlockDefinition(integration)
+		blockConditions = append(blockConditions, generateKeyTypeCondition(integration.KeyType, name))
+	}
+	definitions["block"].(map[string]interface{})["allOf"] = blockConditions
+
+	triggerConditions := make([]interface{}, 0)
+	for _, integration := range actionDefinitions {
+		name := "trigger-" + integration.KeyType
+		definitions[name] = generateTriggerDefinition(integration)
+		triggerConditions = append(triggerConditions, generateKeyTypeCondition(integration.KeyType, name))
+	}
+	definitions["trigger"].(map[string]interface{})["allOf"] = triggerConditions
+
 	return baseSchema, nil
 }
 
+func generateKeyTypeCondition(keyType string, definitionName string) map[string]interface{} {
+	return map[string]interface{}{
+		"if": map[string]interface{}{
+			"required": []string{"keyType"},
+			"properties": map[string]interface{}{
+				"keyType": map[string]interface{}{
+					"const": keyType,
+				},
+			},
+		},
+		"then": map[string]interface{}{
+			"$ref": "#/definitions/" + definitionName,
+		},
+	}
+}
+
+func generateBlockDefinition(integration IntegrationDefinition) map[string]interface{} {
+	propertyKeys := make([]string, 0)
+	propertyTypes := make([]string, 0)
+	for _, property := range integration.Properties {
+		propertyKeys = append(propertyKeys, property.Key)
+		propertyTypes = append(propertyTypes, property.Type)
+	}
+
+	dataKeys := make([]string, 0)
+	dataTypes := make([]string, 0)
+	for _, dataItem := range integration.Data {
+		dataKeys = append(dataKeys, dataItem.Key)
+		dataTypes = append(dataTypes, dataItem.Type)
+	}
+
+	slots := make([]string, 0)
+	for _, slot := range integration.Slots {
+		slots = append(slots, slot.Slot)
+	}
+
+	events := make([]string, 0)
+	for _, event := range integration.Events {
+		events = append(events, event.Event)
+	}
+
+	return map[string]interface{}{
+		"properties": map[string]interface{}{
+			"properties": generateKeyedItemsDefinition(propertyKeys, propertyTypes),
+			"data":       generateKeyedItemsDefinition(dataKeys, dataTypes),
+			"slots":      generateEnumItemsDefinition("slot", slots),
+			"actions":    generateEnumItemsDefinition("event", events),
+		},
+	}
+}
+
+func generateTriggerDefinition(integration IntegrationDefinition) map[string]interface{} {
+	propertyKeys := make([]string, 0)
+	propertyTypes := make([]string, 0)
+	for _, property := range integration.Properties {
+		propertyKeys = append(propertyKeys, property.Key)
+		propertyTypes = append(propertyTypes, property.Type)
+	}
+
+	dataKeys := make([]string, 0)
+	dataTypes := make([]string, 0)
+	for _, dataItem := range integration.Data {
+		dataKeys = append(dataKeys, dataItem.Key)
+		dataTypes = append(dataTypes, dataItem.Type)
+	}
+
+	return map[string]interface{}{
+		"properties": map[string]interface{}{
+			"properties": generateKeyedItemsDefinition(propertyKeys, propertyTypes),
+			"data":       generateKeyedItemsDefinition(dataKeys, dataTypes),
+		},
+	}
+}
+
+func generateKeyedItemsDefinition(keys []string, types []string) map[string]interface{} {
+	if len(keys) == 0 {
+		return map[string]interface{}{
+			"type":     "array",
+			"maxItems": 0,
+		}
+	}
+
+	variants := make([]interface{}, 0)
+	for i, key := range keys {
+		variant := map[string]interface{}{
+			"properties": map[string]interface{}{
+				"key": map[string]interface{}{
+					"const": key,
+				},
+			},
+		}
+		if types[i] != "" {
+			variant["properties"].(map[string]interface{})["type"] = map[string]interface{}{
+				"type": "string",
+				"enum": []string{types[i]},
+			}
+		}
+		variants = append(variants, variant)
+	}
+
+	return map[string]interface{}{
+		"type": "array",
+		"items": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"key": map[string]interface{}{
+					"type": "string",
+					"enum": getUniqueKeys(keys),
+				},
+			},
+			"oneOf": variants,
+		},
+	}
+}
+
+func generateEnumItemsDefinition(field string, values []string) map[string]interface{} {
+	if len(values) == 0 {
+		return map[string]interface{}{
+			"type":     "array",
+			"maxItems": 0,
+		}
+	}
+
+	return map[string]interface{}{
+		"type": "array",
+		"items": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				field: map[string]interface{}{
+					"type": "string",
+					"enum": getUniqueKeys(values),
+				},
+			},
+		},
+	}
+}
+
+func mapIntegrationDefinitions(integrations map[string]interface{}) ([]IntegrationDefinition, error) {
+	definitions := make([]IntegrationDefinition, 0)
+	for key, value := range integrations {
+		if key == "schema-version" {
+			continue
+		}
+
+		integrationBytes, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		var definition IntegrationDefinition
+		if err := json.Unmarshal(integrationBytes, &definition); err != nil {
+			return nil, fmt.Errorf("error parsing integration %s: %w", key, err)
+		}
+		definitions = append(definitions, definition)
+	}
+
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].KeyType < definitions[j].KeyType
+	})
+	return definitions, nil
+}
+
 func getUniqueKeys[T comparable](sliceList []T) []T {
 	allKeys := make(map[T]bool)
 	var list = make([]T, 0)
@@ -577,22 +772,26 @@ func findIntegrations(dirPath string) map[string]interface{} {
 		if integrationItem.Kind == "BLOCK" {
 			if integrationItem.KeyType != "" {
 				result[integrationItem.KeyType] = map[string]interface{}{
-					"keyType":    integrationItem.KeyType,
-					"version":    integrationItem.Version,
-					"properties": properties,
-					"data":       data,
-					"events":     events,
-					"slots":      slots,
+					"keyType":          integrationItem.KeyType,
+					"version":          integrationItem.Version,
+					"deprecated":       integrationItem.Deprecated,
+					"deprecatedReason": integrationItem.DeprecatedReason,
+					"properties":       properties,
+					"data":             data,
+					"events":           events,
+					"slots":            slots,
 				}
 			}
 		} else {
 			if integrationItem.KeyType != "" {
 				result[integrationItem.KeyType] = map[string]interface{}{
-					"keyType":    integrationItem.KeyType,
-					"version":    integrationItem.Version,
-					"properties": properties,
-					"data":       data,
-					"events":     events,
+					"keyType":          integrationItem.KeyType,
+					"version":          integrationItem.Version,
+					"deprecated":       integrationItem.Deprecated,
+					"deprecatedReason": integrationItem.DeprecatedReason,
+					"properties":       properties,
+					"data":             data,
+					"events":           events,
 				}
 			}
 		}
@@ -182,12 +182,14 @@ func projectSchemaGenCmd() *cobra.Command {
 						blockEvents = append(blockEvents, event.Event)
 					}
 					block := map[string]interface{}{
-						"keyType":    installedIntegration.IntegrationKeyType,
-						"version":    installedIntegration.IntegrationVersion,
-						"data":       installedIntegration.IntegrationData,
-						"properties": installedIntegration.IntegrationProperties,
-						"slots":      installedIntegration.IntegrationSlots,
-						"events":     installedIntegration.IntegrationEvents,
+						"keyType":          installedIntegration.IntegrationKeyType,
+						"version":          installedIntegration.IntegrationVersion,
+						"deprecated":       installedIntegration.IntegrationDeprecated,
+						"deprecatedReason": installedIntegration.IntegrationDeprecatedReason,
+						"data":             installedIntegration.IntegrationData,
+						"properties":       installedIntegration.IntegrationProperties,
+						"slots":            installedIntegration.IntegrationSlots,
+						"events":           installedIntegration.IntegrationEvents,
 					}
 
 					if installedIntegration.IntegrationData == nil {
@@ -220,11 +222,13 @@ func projectSchemaGenCmd() *cobra.Command {
 						actionData = append(actionData, dataItem.Key)
 					}
 					action := map[string]interface{}{
-						"keyType":    installedIntegration.IntegrationKeyType,
-						"version":    installedIntegration.IntegrationVersion,
-						"data":       installedIntegration.IntegrationData,
-						"properties": installedIntegration.IntegrationProperties,
-						"events":     installedIntegration.IntegrationEvents,
+						"keyType":          installedIntegration.IntegrationKeyType,
+						"version":          installedIntegration.IntegrationVersion,
+						"deprecated":       installedIntegration.IntegrationDeprecated,
+						"deprecatedReason": installedIntegration.IntegrationDeprecatedReason,
+						"data":             installedIntegration.IntegrationData,
+						"properties":       installedIntegration.IntegrationProperties,
+						"events":           installedIntegration.IntegrationEvents,
 					}
 
 					if installedIntegration.IntegrationData == nil {
@@ -260,10 +264,19 @@ func projectSchemaGenCmd() *cobra.Command {
 				blockKeyTypes = append(blockKeyTypes, "ROOT")
 			}
 
+			blockDefinitions, err := mapIntegrationDefinitions(blocks)
+			if err != nil {
+				return err
+			}
+			actionDefinitions, err := mapIntegrationDefinitions(actions)
+			if err != nil {
+				return err
+			}
+
 			blocks["schema-version"] = version
 			actions["schema-version"] = version
 
-			schema, err := generateBaseSchema(version, blockKeyTypes, actionKeyTypes, blockProperties, blockData, blockSlots, blockEvents, actionProperties, actionData)
+			schema, err := generateBaseSchema(version, blockKeyTypes, actionKeyTypes, blockProperties, blockData, blockSlots, blockEvents, actionProperties, actionData, blockDefinitions, actionDefinitions)
 			if err != nil {
 				return nil
 			}
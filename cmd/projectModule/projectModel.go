@@ -17,34 +17,44 @@ type ProjectsResponse struct {
 }
 
 type IntegrationPropertyModel struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
-	Type  string `json:"type"`
+	Key              string `json:"key"`
+	Value            string `json:"value"`
+	Type             string `json:"type"`
+	Deprecated       bool   `json:"deprecated"`
+	DeprecatedReason string `json:"deprecatedReason"`
 }
 
 type IntegrationDataModel struct {
-	Key  string `json:"key"`
-	Type string `json:"type"`
+	Key              string `json:"key"`
+	Type             string `json:"type"`
+	Deprecated       bool   `json:"deprecated"`
+	DeprecatedReason string `json:"deprecatedReason"`
 }
 
 type IntegrationEventModel struct {
-	Event string `json:"event"`
+	Event            string `json:"event"`
+	Deprecated       bool   `json:"deprecated"`
+	DeprecatedReason string `json:"deprecatedReason"`
 }
 
 type IntegrationSlotModel struct {
-	Slot string `json:"slot"`
+	Slot             string `json:"slot"`
+	Deprecated       bool   `json:"deprecated"`
+	DeprecatedReason string `json:"deprecatedReason"`
 }
 
 type IntegrationProjectModel struct {
-	IntegrationKeyType         string                     `json:"integrationKeyType"`
-	IntegrationVersion         int8                       `json:"integrationVersion"`
-	IntegrationID              string                     `json:"integrationId"`
-	IntegrationPlatformSupport string                     `json:"integrationPlatformSupport"`
-	IntegrationKind            string                     `json:"integrationKind"`
-	IntegrationProperties      []IntegrationPropertyModel `json:"integrationProperties"`
-	IntegrationData            []IntegrationDataModel     `json:"integrationData"`
-	IntegrationEvents          []IntegrationEventModel    `json:"integrationEvents"`
-	IntegrationSlots           []IntegrationSlotModel     `json:"integrationSlots"`
+	IntegrationKeyType          string                     `json:"integrationKeyType"`
+	IntegrationVersion          int8                       `json:"integrationVersion"`
+	IntegrationID               string                     `json:"integrationId"`
+	IntegrationPlatformSupport  string                     `json:"integrationPlatformSupport"`
+	IntegrationKind             string                     `json:"integrationKind"`
+	IntegrationDeprecated       bool                       `json:"integrationDeprecated"`
+	IntegrationDeprecatedReason string                     `json:"integrationDeprecatedReason"`
+	IntegrationProperties       []IntegrationPropertyModel `json:"integrationProperties"`
+	IntegrationData             []IntegrationDataModel     `json:"integrationData"`
+	IntegrationEvents           []IntegrationEventModel    `json:"integrationEvents"`
+	IntegrationSlots            []IntegrationSlotModel     `json:"integrationSlots"`
 }
 
 type InstalledIntegrationResponse struct {
@@ -79,44 +89,54 @@ func mapIntegrationsResponseToModel(response InstalledIntegrationResponse) []Int
 		var properties []IntegrationPropertyModel
 		for _, prop := range integration.IntegrationProperties {
 			properties = append(properties, IntegrationPropertyModel{
-				Key:   prop.Key,
-				Value: prop.Value,
-				Type:  prop.Type,
+				Key:              prop.Key,
+				Value:            prop.Value,
+				Type:             prop.Type,
+				Deprecated:       prop.Deprecated,
+				DeprecatedReason: prop.DeprecatedReason,
 			})
 		}
 
 		var data []IntegrationDataModel
 		for _, dataItem := range integration.IntegrationData {
 			data = append(data, IntegrationDataModel{
-				Key:  dataItem.Key,
-				Type: dataItem.Type,
+				Key:              dataItem.Key,
+				Type:             dataItem.Type,
+				Deprecated:       dataItem.Deprecated,
+				DeprecatedReason: dataItem.DeprecatedReason,
 			})
 		}
 
 		var events []IntegrationEventModel
 		for _, event := range integration.IntegrationEvents {
 			events = append(events, IntegrationEventModel{
-				Event: event.Event,
+				Event:            event.Event,
+				Deprecated:       event.Deprecated,
+				DeprecatedReason: event.DeprecatedReason,
 			})
 		}
 
 		var slots []IntegrationSlotModel
 		for _, slot := range integration.IntegrationSlots {
 			slots = append(slots, IntegrationSlotModel{
-				Slot: slot.Slot,
+				Slot:             slot.Slot,
+				Deprecated:       slot.Deprecated,
+				DeprecatedReason: slot.DeprecatedReason,
 			})
 		}
 
 		integrationModel := IntegrationProjectModel{
-			IntegrationKeyType:         integration.IntegrationKeyType,
-			IntegrationVersion:         integration.IntegrationVersion,
-			IntegrationID:              integration.IntegrationID,
-			IntegrationPlatformSupport: integration.IntegrationPlatformSupport,
-			IntegrationKind:            integration.IntegrationKind,
-			IntegrationProperties:      properties,
-			IntegrationData:            data,
-			IntegrationEvents:          events,
-			IntegrationSlots:           slots,
+			IntegrationKeyType:          integration.IntegrationKeyType,
+			IntegrationVersion:          integration.IntegrationVersion,
+			IntegrationID:               integration.IntegrationID,
+			IntegrationPlatformSupport:  integration.IntegrationPlatformSupport,
+			IntegrationKind:             integration.IntegrationKind,
+			IntegrationDeprecated:       integration.IntegrationDeprecated,
+			IntegrationDeprecatedReason: integration.IntegrationDeprecatedReason,
+			IntegrationProperties:       properties,
+			IntegrationData:             data,
+			IntegrationEvents:           events,
+			IntegrationSlots:            slots,
 		}
 		integrationModels = append(integrationModels, integrationModel)
 	}
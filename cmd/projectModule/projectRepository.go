@@ -50,6 +50,43 @@ const installedIntegrationsQuery = `
 	}
 `
 
+const installedIntegrationsWithDeprecationsQuery = `
+	query integrationsInstalled($organizationId: String!, $projectId: String!, $kind: String!) {
+		integrationsInstalled(organizationId: $organizationId, projectId: $projectId, kind: $kind) {
+			integrationKeyType
+			integrationVersion
+			integrationId
+			integrationPlatformSupport
+			integrationKind
+			integrationDeprecated
+			integrationDeprecatedReason
+			integrationProperties {
+				key
+				value
+				type
+				deprecated
+				deprecatedReason
+			}
+			integrationData {
+				key
+				type
+				deprecated
+				deprecatedReason
+			}
+			integrationEvents {
+				event
+				deprecated
+				deprecatedReason
+			}
+			integrationSlots {
+				slot
+				deprecated
+				deprecatedReason
+			}
+		}
+	}
+`
+
 func GetProjects(regionUrl string, accessToken string, organizationId string) ([]ProjectModel, error) {
 	client := graphqlutil.NewClient()
 
@@ -114,9 +151,17 @@ func GetInstalledIntegration(regionUrl string, accessToken string, organizationI
 	apiResponse, err := client.Execute(
 		regionUrl,
 		headers,
-		installedIntegrationsQuery,
+		installedIntegrationsWithDeprecationsQuery,
 		variables,
 	)
+	if graphqlutil.IsValidationError(err) {
+		apiResponse, err = client.Execute(
+			regionUrl,
+			headers,
+			installedIntegrationsQuery,
+			variables,
+		)
+	}
 	if err != nil {
 		return nil, errors.New("failed to fetch installed integrations: " + err.Error())
 	}
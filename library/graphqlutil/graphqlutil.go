@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 )
 
 type GraphQLRequest struct {
@@ -78,6 +79,16 @@ func (c *Client) Execute(url string, headers map[string]string, query string, va
 	return &graphQLResp, nil
 }
 
+func IsValidationError(err error) bool {
+	if err == nil {
+		return false
+	}
+	message := err.Error()
+	return strings.Contains(message, "Cannot query field") ||
+		strings.Contains(message, "Unknown field") ||
+		strings.Contains(message, "GRAPHQL_VALIDATION_FAILED")
+}
+
 func Parse(resp *GraphQLResponse, data interface{}) error {
 	responseData, err := json.Marshal(resp.Data)
 	if err != nil {